@@ -0,0 +1,10 @@
+package build
+
+// The following defaults mirror the main package's; they're overwritten during program initialization.
+var (
+	Version = "0.0.0"   // Version is the executable's semantic version.
+	Commit  = "n/a"     // Commit is the VCS revision the executable was built from.
+	Date    = "latest"  // Date is the executable's build timestamp.
+	Sources = "include" // Sources enables source-location logging when "include" (see "-X main.sources=no-include").
+	Key     = ""        // Key is the base64-encoded ed25519 public key verifying release signatures (see "-X main.key=...").
+)
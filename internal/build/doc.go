@@ -0,0 +1,3 @@
+// Package build exposes the executable's compile-time build metadata. The values are assigned by the main package
+// from its linker-injected variables (see "-ldflags -X main.version=...").
+package build
@@ -0,0 +1,166 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"template-go-cli/internal/conventional"
+	"template-go-cli/internal/git"
+	"template-go-cli/internal/semver"
+)
+
+// Titles maps each commit type to its section title. Sections are rendered in [Order].
+var Titles = map[string]string{
+	"feat":     "Features",
+	"fix":      "Bug Fixes",
+	"perf":     "Performance Improvements",
+	"revert":   "Reverts",
+	"refactor": "Code Refactoring",
+	"docs":     "Documentation",
+	"build":    "Build System",
+	"ci":       "Continuous Integration",
+	"bump":     "Dependencies",
+	"style":    "Styles",
+	"test":     "Tests",
+	"chore":    "Chores",
+	Other:      "Other Changes",
+}
+
+// Order is the order in which sections are rendered.
+var Order = []string{"feat", "fix", "perf", "revert", "refactor", "docs", "build", "ci", "bump", "style", "test", "chore", Other}
+
+// Other is the section type of commits that aren't conventional, or whose type isn't recognized.
+const Other = "other"
+
+// Entry represents a single changelog line item.
+type Entry struct {
+	Hash        string   `json:"hash" yaml:"hash"`
+	Scope       string   `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Description string   `json:"description" yaml:"description"`
+	Breaking    bool     `json:"breaking" yaml:"breaking"`
+	Notes       []string `json:"notes,omitempty" yaml:"notes,omitempty"`
+}
+
+// Section groups the entries of a single commit type.
+type Section struct {
+	Type    string  `json:"type" yaml:"type"`
+	Title   string  `json:"title" yaml:"title"`
+	Entries []Entry `json:"entries" yaml:"entries"`
+}
+
+// Changelog represents the changes between two revisions.
+type Changelog struct {
+	From     string    `json:"from,omitempty" yaml:"from,omitempty"` // From is the (exclusive) starting revision; empty for the full history.
+	To       string    `json:"to" yaml:"to"`
+	Previous string    `json:"previous" yaml:"previous"`
+	Next     string    `json:"next" yaml:"next"`
+	Bump     string    `json:"bump" yaml:"bump"` // Bump is the suggested [semver.Increment], or empty if nothing warrants a release.
+	Breaking []Entry   `json:"breaking,omitempty" yaml:"breaking,omitempty"`
+	Sections []Section `json:"sections" yaml:"sections"`
+}
+
+// Build groups the commits by type and suggests the next version from previous: any breaking change warrants a
+// major increment, features a minor increment, and fixes or performance improvements a patch increment.
+func Build(from, to string, previous semver.Version, commits []git.Entry) (*Changelog, error) {
+	var changelog = &Changelog{From: from, To: to, Previous: previous.String(), Next: previous.String()}
+
+	var groups = map[string][]Entry{}
+	var increment semver.Increment
+
+	for _, commit := range commits {
+		parsed, e := conventional.Parse(conventional.Clean(commit.Message))
+
+		kind := parsed.Type
+		if _, valid := conventional.Types[kind]; e != nil || !valid {
+			kind = Other
+			parsed = conventional.Commit{Description: strings.SplitN(commit.Message, "\n", 2)[0]}
+		}
+
+		entry := Entry{Hash: commit.Hash, Scope: parsed.Scope, Description: parsed.Description, Breaking: parsed.Breaking, Notes: parsed.Notes()}
+
+		groups[kind] = append(groups[kind], entry)
+
+		switch {
+		case entry.Breaking:
+			changelog.Breaking = append(changelog.Breaking, entry)
+			increment = semver.Major
+		case kind == "feat" && increment != semver.Major:
+			increment = semver.Minor
+		case (kind == "fix" || kind == "perf") && increment == "":
+			increment = semver.Patch
+		}
+	}
+
+	for _, kind := range Order {
+		if entries := groups[kind]; len(entries) > 0 {
+			changelog.Sections = append(changelog.Sections, Section{Type: kind, Title: Titles[kind], Entries: entries})
+		}
+	}
+
+	if increment != "" {
+		next, e := previous.Bump(increment, "")
+		if e != nil {
+			return nil, e
+		}
+
+		changelog.Bump = string(increment)
+		changelog.Next = next.String()
+	}
+
+	return changelog, nil
+}
+
+// Markdown renders the changelog as a Markdown document.
+func (c *Changelog) Markdown(w io.Writer) error {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "## %s\n", c.Next)
+
+	if c.Bump == "" {
+		builder.WriteString("\nNo releasable changes.\n")
+	}
+
+	if len(c.Breaking) > 0 {
+		builder.WriteString("\n### ⚠ BREAKING CHANGES\n\n")
+		for _, entry := range c.Breaking {
+			notes := entry.Notes
+			if len(notes) == 0 {
+				notes = []string{entry.Description}
+			}
+
+			for _, note := range notes {
+				fmt.Fprintf(&builder, "- %s%s\n", scope(entry), strings.ReplaceAll(note, "\n", "\n  "))
+			}
+		}
+	}
+
+	for _, section := range c.Sections {
+		fmt.Fprintf(&builder, "\n### %s\n\n", section.Title)
+		for _, entry := range section.Entries {
+			fmt.Fprintf(&builder, "- %s%s (%s)\n", scope(entry), entry.Description, short(entry.Hash))
+		}
+	}
+
+	_, e := io.WriteString(w, builder.String())
+
+	return e
+}
+
+// scope returns the entry's bolded scope prefix, if any.
+func scope(entry Entry) string {
+	if entry.Scope == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("**%s:** ", entry.Scope)
+}
+
+// short abbreviates a commit hash.
+func short(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+
+	return hash
+}
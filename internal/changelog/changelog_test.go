@@ -0,0 +1,140 @@
+package changelog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/git"
+	"template-go-cli/internal/semver"
+)
+
+// entries returns git log entries -- most recent first -- for the messages, hashed by their index.
+func entries(messages ...string) []git.Entry {
+	var collection []git.Entry
+	for index, message := range messages {
+		collection = append(collection, git.Entry{Hash: strings.Repeat(string(rune('a'+index)), 40), Message: message})
+	}
+
+	return collection
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		messages []string
+		bump     string
+		next     string
+		breaking int
+		sections []string
+	}{
+		{name: "empty", previous: "1.2.3", next: "1.2.3"},
+		{name: "unreleasable", previous: "1.2.3", messages: []string{"docs: explain", "chore: tidy"}, next: "1.2.3", sections: []string{"docs", "chore"}},
+		{name: "patch", previous: "1.2.3", messages: []string{"fix: handle empty input", "docs: explain"}, bump: "patch", next: "1.2.4", sections: []string{"fix", "docs"}},
+		{name: "performance", previous: "1.2.3", messages: []string{"perf: cache lookups"}, bump: "patch", next: "1.2.4", sections: []string{"perf"}},
+		{name: "minor", previous: "1.2.3", messages: []string{"fix: handle empty input", "feat: add a command"}, bump: "minor", next: "1.3.0", sections: []string{"feat", "fix"}},
+		{name: "breaking-marker", previous: "1.2.3", messages: []string{"feat: add a command", "fix(api)!: remove the legacy endpoint"}, bump: "major", next: "2.0.0", breaking: 1, sections: []string{"feat", "fix"}},
+		{name: "breaking-footer", previous: "1.2.3", messages: []string{"refactor: rename\n\nBREAKING CHANGE: keys moved", "feat: add a command"}, bump: "major", next: "2.0.0", breaking: 1, sections: []string{"feat", "refactor"}},
+		{name: "other", previous: "1.2.3", messages: []string{"Merge branch 'main'", "unknown: type", "fix: handle empty input"}, bump: "patch", next: "1.2.4", sections: []string{"fix", Other}},
+		{name: "pre-release", previous: "1.3.0-rc.1", messages: []string{"feat: add a command"}, bump: "minor", next: "1.3.0", sections: []string{"feat"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changelog, e := Build("v"+test.previous, "HEAD", semver.MustParse(test.previous), entries(test.messages...))
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if changelog.Bump != test.bump || changelog.Next != test.next || changelog.Previous != test.previous {
+				t.Fatalf("expected %s -> %s (%q), received %s -> %s (%q)", test.previous, test.next, test.bump, changelog.Previous, changelog.Next, changelog.Bump)
+			}
+
+			if len(changelog.Breaking) != test.breaking {
+				t.Fatalf("expected %d breaking change(s), received %d", test.breaking, len(changelog.Breaking))
+			}
+
+			var sections []string
+			for _, section := range changelog.Sections {
+				sections = append(sections, section.Type)
+
+				if section.Title != Titles[section.Type] {
+					t.Errorf("unexpected title %q for section %q", section.Title, section.Type)
+				}
+			}
+
+			if !reflect.DeepEqual(sections, test.sections) {
+				t.Fatalf("expected sections %q, received %q", test.sections, sections)
+			}
+		})
+	}
+}
+
+func TestBuildEntries(t *testing.T) {
+	changelog, e := Build("", "HEAD", semver.MustParse("0.1.0"), entries(
+		"feat(cli)!: rename flags\n\nBREAKING CHANGE: \"-n\" is now \"--name\"",
+		"feat: add a command",
+		"not conventional\n\nwith a body",
+	))
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	// Entries retain the log's order within their section.
+	features := changelog.Sections[0]
+	if features.Type != "feat" || len(features.Entries) != 2 {
+		t.Fatalf("unexpected section: %+v", features)
+	}
+
+	expected := Entry{Hash: strings.Repeat("a", 40), Scope: "cli", Description: "rename flags", Breaking: true, Notes: []string{"\"-n\" is now \"--name\""}}
+	if !reflect.DeepEqual(features.Entries[0], expected) || !reflect.DeepEqual(changelog.Breaking, []Entry{expected}) {
+		t.Fatalf("unexpected breaking entry: %+v", features.Entries[0])
+	}
+
+	if features.Entries[1].Description != "add a command" {
+		t.Fatalf("unexpected entry: %+v", features.Entries[1])
+	}
+
+	// Non-conventional commits are listed by their first line.
+	other := changelog.Sections[1]
+	if other.Type != Other || len(other.Entries) != 1 || other.Entries[0].Description != "not conventional" {
+		t.Fatalf("unexpected section: %+v", other)
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	changelog, e := Build("v1.2.3", "HEAD", semver.MustParse("1.2.3"), entries(
+		"feat(cli)!: rename flags\n\nBREAKING CHANGE: \"-n\" is now \"--name\"",
+		"fix: handle empty input",
+	))
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var builder strings.Builder
+	if e := changelog.Markdown(&builder); e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	expected := strings.Join([]string{
+		"## 2.0.0",
+		"",
+		"### ⚠ BREAKING CHANGES",
+		"",
+		"- **cli:** \"-n\" is now \"--name\"",
+		"",
+		"### Features",
+		"",
+		"- **cli:** rename flags (aaaaaaa)",
+		"",
+		"### Bug Fixes",
+		"",
+		"- handle empty input (bbbbbbb)",
+		"",
+	}, "\n")
+
+	if builder.String() != expected {
+		t.Fatalf("unexpected markdown:\n%s", builder.String())
+	}
+}
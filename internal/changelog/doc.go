@@ -0,0 +1,2 @@
+// Package changelog builds and renders a changelog from a range of conventional commits.
+package changelog
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"io"
+	"time"
+
+	"template-go-cli/internal/commands"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// Options configures the root command returned by [New]. The zero value uses the process' standard streams,
+// environment, filesystem and clock.
+//
+// The environment, filesystem and clock are honored by every built-in command and by the cli's own settings (the
+// configuration file, the per-user directories, plugin discovery, the update notifier and crash reports). The
+// following remain bound to the host:
+//
+//   - sub-processes (git, plugins) run against the host's filesystem, though with the injected environment;
+//   - "self-update" replaces, and verifies, the running executable;
+//   - "lint install-hooks" resolves the executable via the process' PATH;
+//   - "shell" reads and writes its history file, and completes paths, on the host's filesystem;
+//   - flag values referencing files ("@file" values, "~" paths) are resolved against the host as they're parsed;
+//   - the "--failure-log" file is written to the host's filesystem;
+//   - terminal detection inspects the process' standard streams.
+type Options struct {
+	Stdin  io.Reader // Stdin is the commands' standard-input; defaults to [os.Stdin].
+	Stdout io.Writer // Stdout is the commands' standard-output; defaults to [os.Stdout].
+	Stderr io.Writer // Stderr is the commands' standard-error, including logs; defaults to [os.Stderr].
+
+	// Env is the commands' environment, in [os.Environ]'s "key=value" form; defaults to the process' environment.
+	// See [system.Environment] to build one from a map.
+	Env []string
+
+	Filesystem system.Filesystem // Filesystem is the commands' filesystem; defaults to the host's.
+	Clock      func() time.Time  // Clock returns the current time; defaults to [time.Now].
+}
+
+// New returns a new root command, configured with options, with every registered child command and discovered
+// plugin attached (see [commands.New]). Execute it via [commands.Execute] or [commands.Run].
+//
+// Each call builds an independent command tree, including its flags' state; a root is intended for a single
+// execution.
+func New(options Options) (*cobra.Command, error) {
+	root, e := commands.New(system.System{Env: options.Env, Filesystem: options.Filesystem, Clock: options.Clock})
+	if e != nil {
+		return nil, exceptions.Wrap(exceptions.Internal, e, "unable to build command tree")
+	}
+
+	root.SetIn(options.Stdin)
+	root.SetOut(options.Stdout)
+	root.SetErr(options.Stderr)
+
+	return root, nil
+}
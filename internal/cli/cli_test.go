@@ -0,0 +1,152 @@
+package cli_test
+
+import (
+	"bytes"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"template-go-cli/internal/cli"
+	"template-go-cli/internal/commands"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// memory is an in-memory [system.Filesystem] recording written files.
+type memory map[string][]byte
+
+func (m memory) ReadFile(name string) ([]byte, error) {
+	if content, ok := m[name]; ok {
+		return content, nil
+	}
+
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (m memory) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	m[name] = bytes.Clone(data)
+
+	return nil
+}
+
+func (m memory) ReadDir(name string) ([]fs.DirEntry, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (m memory) Stat(name string) (fs.FileInfo, error) {
+	return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+}
+
+func (m memory) MkdirAll(path string, perm fs.FileMode) error { return nil }
+
+func (m memory) Chmod(name string, mode fs.FileMode) error { return nil }
+
+func (m memory) Rename(from, to string) error {
+	content, ok := m[from]
+	if !ok {
+		return &fs.PathError{Op: "rename", Path: from, Err: fs.ErrNotExist}
+	}
+
+	delete(m, from)
+
+	m[to] = content
+
+	return nil
+}
+
+func (m memory) Remove(name string) error {
+	delete(m, name)
+
+	return nil
+}
+
+// isolate returns an environment pointing the cli's settings at temporary directories, merged with variables.
+func isolate(t *testing.T, variables map[string]string) []string {
+	home := t.TempDir()
+
+	environment := map[string]string{
+		"HOME":                                   home,
+		"XDG_CONFIG_HOME":                        filepath.Join(home, ".config"),
+		"XDG_STATE_HOME":                         filepath.Join(home, ".local", "state"),
+		constants.Prefix + "_NO_UPDATE_NOTIFIER": "1",
+	}
+
+	maps.Copy(environment, variables)
+
+	return system.Environment(environment)
+}
+
+// build returns a new root command configured with options, failing the test if it can't be built.
+func build(t *testing.T, options cli.Options) *cobra.Command {
+	t.Helper()
+
+	root, e := cli.New(options)
+	if e != nil {
+		t.Fatalf("unable to build root command: %v", e)
+	}
+
+	return root
+}
+
+func TestNew(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	root := build(t, cli.Options{Stdout: &stdout, Stderr: &stderr, Env: isolate(t, nil)})
+
+	if code := commands.Run(root, []string{"example", "--name", "value", "--output", "json"}); code != 0 {
+		t.Fatalf("unexpected exit code %d; standard-error:\n%s", code, stderr.String())
+	}
+
+	if !strings.Contains(stdout.String(), `"name": "value"`) {
+		t.Fatalf("unexpected standard-output:\n%s", stdout.String())
+	}
+
+	again := build(t, cli.Options{Env: isolate(t, nil)})
+	if again == root {
+		t.Fatal("expected successive calls to return independent root commands")
+	}
+
+	// Flag state belongs to each tree: the first execution's "--name" mustn't leak into the second's.
+	first, _, _ := root.Find([]string{"example"})
+	second, _, _ := again.Find([]string{"example"})
+
+	if first == second {
+		t.Fatal("expected successive calls to construct independent child commands")
+	}
+
+	if flag := second.Flags().Lookup("name"); flag == nil || flag.Changed || flag.Value.String() != "" {
+		t.Fatalf("expected the second tree's \"--name\" flag to be unset; received %v", flag)
+	}
+}
+
+func TestNewSystem(t *testing.T) {
+	var stderr bytes.Buffer
+
+	filesystem := memory{}
+
+	root := build(t, cli.Options{
+		Stderr:     &stderr,
+		Env:        isolate(t, map[string]string{"SOURCE_DATE_EPOCH": "0"}),
+		Filesystem: filesystem,
+		Clock:      func() time.Time { return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC) },
+	})
+
+	if code := commands.Run(root, []string{"docs", "man", "--directory", "manuals"}); code != 0 {
+		t.Fatalf("unexpected exit code %d; standard-error:\n%s", code, stderr.String())
+	}
+
+	page, ok := filesystem[filepath.Join("manuals", constants.Name+".1")]
+	if !ok {
+		t.Fatalf("expected the root's man page to be written; received %d file(s)", len(filesystem))
+	}
+
+	// The injected environment's epoch takes precedence over the injected clock.
+	if !strings.Contains(string(page), `"Jan 1970"`) {
+		t.Fatalf("unexpected man page header:\n%s", strings.SplitN(string(page), "\n", 2)[0])
+	}
+}
@@ -0,0 +1,4 @@
+// Package cli builds the command-line interface's root command -- its persistent flags, logging bootstrap,
+// registered child commands and external plugins -- with injectable standard streams, environment, filesystem and
+// clock. The main package is a thin wrapper around [New].
+package cli
@@ -0,0 +1,115 @@
+package changelog
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"template-go-cli/internal/changelog"
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/git"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/semver"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the changelog command.
+func New() *cobra.Command {
+	var (
+		directory string
+		from      string
+		to        string
+	)
+
+	var command = &cobra.Command{
+		Use:   "changelog",
+		Short: "Generate a changelog from conventional commits",
+		Long:  "Reads the git history between two revisions -- by default, the most recent tag and HEAD -- parses each Conventional Commit's type, scope, \"!\" breaking marker and \"BREAKING CHANGE\" footer(s), then renders a changelog grouped by type along with the suggested next semantic version. Markdown is rendered unless an --output format is explicitly specified.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Changes since the most recent tag"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s changelog", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Changes between two tags, as JSON"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s changelog --from v1.0.0 --to v1.1.0 --output json", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			repository, e := git.Open(ctx, directory)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "not a git repository")
+			}
+
+			start := from
+			if start == "" {
+				if start, e = repository.Describe(ctx, to); e != nil {
+					return e
+				}
+			}
+
+			var previous semver.Version
+			if start != "" {
+				if previous, e = semver.Parse(strings.TrimPrefix(start, "v")); e != nil {
+					log.WarnContext(ctx, "Starting revision isn't a semantic version tag; assuming 0.0.0", slog.String("revision", start))
+				}
+			}
+
+			revisions := to
+			if start != "" {
+				revisions = start + ".." + to
+			}
+
+			log.Log(ctx, level.Trace.Level(), "Reading commit history", slog.String("revisions", revisions))
+
+			commits, e := repository.Log(ctx, revisions)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "unable to read revisions %q", revisions)
+			}
+
+			datum, e := changelog.Build(start, to, previous, commits)
+			if e != nil {
+				return e
+			}
+
+			result.Record(ctx, datum)
+
+			if !cmd.Flags().Changed("output") {
+				return datum.Markdown(cmd.OutOrStdout())
+			}
+
+			buffer, e := output.Write(format.Get(ctx), datum)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVarP(&directory, "directory", "C", ".", "a directory within the repository")
+	flags.StringVar(&from, "from", "", "the (exclusive) starting revision; defaults to the most recent tag reachable from --to")
+	flags.StringVar(&to, "to", "HEAD", "the (inclusive) ending revision")
+
+	return command
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 110,
+	})
+}
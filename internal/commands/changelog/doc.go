@@ -0,0 +1,2 @@
+// Package changelog provides the changelog cli sub-command.
+package changelog
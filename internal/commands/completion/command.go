@@ -0,0 +1,92 @@
+package completion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/completion"
+	"template-go-cli/internal/constants"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the completion command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "completion",
+		Short: "Generate, install or uninstall shell completion scripts",
+		Long:  "Generates the completion script for the specified shell, or installs it to the shell's per-user completion location -- including an idempotent, marked block in the shell's rc file, where necessary.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Install completions for the current shell"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s completion install", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Load completions into the current zsh session"),
+			fmt.Sprintf("  %s", fmt.Sprintf("source <(%s completion zsh)", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Remove installed bash completions"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s completion uninstall bash", constants.Name)),
+		}, "\n"),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		TraverseChildren:  true,
+	}
+
+	for _, shell := range completion.Shells {
+		command.AddCommand(generator(shell))
+	}
+
+	command.AddCommand(install(), uninstall())
+
+	return command
+}
+
+// generator constructs a sub-command that prints the shell's completion script.
+func generator(shell completion.Shell) *cobra.Command {
+	var descriptions bool
+
+	command := &cobra.Command{
+		Use:               string(shell),
+		Short:             fmt.Sprintf("Generate the autocompletion script for %s", shell),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return shell.Generate(cmd.Root(), cmd.OutOrStdout(), descriptions)
+		},
+	}
+
+	command.Flags().BoolVar(&descriptions, "descriptions", true, "include completion descriptions")
+
+	return command
+}
+
+// shells completes the supported shell names.
+func shells(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, shell := range completion.Shells {
+		completions = append(completions, string(shell))
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// resolve returns the shell named by the first argument, or the detected shell if there are no arguments.
+func resolve(ctx context.Context, args []string) (completion.Shell, error) {
+	if len(args) == 0 {
+		return completion.Detect(ctx)
+	}
+
+	return completion.Parse(args[0])
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 100,
+	})
+}
@@ -0,0 +1,3 @@
+// Package completion provides the shell completion cli sub-command(s): printing, installing and uninstalling
+// completion scripts.
+package completion
@@ -0,0 +1,110 @@
+package completion
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/completion"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// install returns the completion install sub-command.
+func install() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "install [bash|zsh|fish|powershell]",
+		Short: "Install the completion script for the specified, or detected, shell",
+		Long:  "Writes the completion script to the shell's per-user completion location, and -- for shells without auto-loading -- adds a marked block to the shell's rc file that loads it. Re-running the command updates both in place.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Install completions for the detected shell"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s completion install", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Install completions for fish"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s completion install fish", constants.Name)),
+		}, "\n"),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: shells,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			shell, e := resolve(ctx, args)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Usage, e, "").WithHint("specify one of: bash, zsh, fish, powershell")
+			}
+
+			log.Log(ctx, level.Trace.Level(), "Installing completion script", "shell", shell)
+
+			target, e := completion.Install(ctx, cmd.Root(), shell)
+			if e != nil {
+				return e
+			}
+
+			result.Record(ctx, target)
+
+			buffer, e := output.Write(format.Get(ctx), target)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	return command
+}
+
+// uninstall returns the completion uninstall sub-command.
+func uninstall() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "uninstall [bash|zsh|fish|powershell]",
+		Short: "Uninstall the completion script for the specified, or detected, shell",
+		Long:  "Removes the completion script and the marked rc file block previously written by the install sub-command.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Uninstall completions for the detected shell"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s completion uninstall", constants.Name)),
+		}, "\n"),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: shells,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			shell, e := resolve(ctx, args)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Usage, e, "").WithHint("specify one of: bash, zsh, fish, powershell")
+			}
+
+			log.Log(ctx, level.Trace.Level(), "Uninstalling completion script", "shell", shell)
+
+			target, e := completion.Uninstall(ctx, shell)
+			if e != nil {
+				return e
+			}
+
+			result.Record(ctx, target)
+
+			buffer, e := output.Write(format.Get(ctx), target)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	return command
+}
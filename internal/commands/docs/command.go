@@ -0,0 +1,196 @@
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/reference"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the docs command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "docs",
+		Short: "Generate reference documentation from the command tree",
+		Long:  "Generates roff man pages, a Markdown reference (one page per command) and a JSON command manifest from the same command tree that renders \"--help\" output.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Generate man pages"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s docs man --directory ./documentation/man", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Generate the Markdown reference"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s docs markdown --directory ./documentation/reference", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Print the command manifest"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s docs manifest", constants.Name)),
+		}, "\n"),
+		Args:             cobra.NoArgs,
+		TraverseChildren: true,
+	}
+
+	command.AddCommand(man(), markdown(), manifest())
+
+	return command
+}
+
+// man returns the docs man sub-command.
+func man() *cobra.Command {
+	var (
+		directory string
+		section   string
+	)
+
+	var command = &cobra.Command{
+		Use:   "man",
+		Short: "Generate roff man pages",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Generating man pages", "directory", directory, "section", section)
+
+			var host = system.Get(ctx)
+
+			date := host.Now()
+
+			// Support reproducible builds; see https://reproducible-builds.org/docs/source-date-epoch/.
+			if epoch, e := strconv.ParseInt(host.Getenv("SOURCE_DATE_EPOCH"), 10, 64); e == nil {
+				date = time.Unix(epoch, 0).UTC()
+			}
+
+			return generate(cmd, directory, fmt.Sprintf(".%s", section), func(w io.Writer, command reference.Command) error {
+				return reference.Man(w, command, section, date)
+			})
+		},
+	}
+
+	command.Flags().StringVarP(&directory, "directory", "d", "", "the output directory")
+	command.Flags().StringVar(&section, "section", "1", "the man page section")
+
+	if e := command.MarkFlagRequired("directory"); e != nil {
+		panic(e)
+	}
+
+	return command
+}
+
+// markdown returns the docs markdown sub-command.
+func markdown() *cobra.Command {
+	var directory string
+
+	var command = &cobra.Command{
+		Use:   "markdown",
+		Short: "Generate a Markdown reference, one page per command",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Generating markdown reference", "directory", directory)
+
+			return generate(cmd, directory, ".md", reference.Markdown)
+		},
+	}
+
+	command.Flags().StringVarP(&directory, "directory", "d", "", "the output directory")
+
+	if e := command.MarkFlagRequired("directory"); e != nil {
+		panic(e)
+	}
+
+	return command
+}
+
+// manifest returns the docs manifest sub-command.
+func manifest() *cobra.Command {
+	var directory string
+
+	var command = &cobra.Command{
+		Use:   "manifest",
+		Short: "Generate the command manifest",
+		Long:  "Generates the command manifest -- every command's usage, flags, examples and groups. The manifest is written to \"manifest.json\" when a directory is specified; otherwise, it's printed using the output format.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Generating command manifest", "directory", directory)
+
+			var datum = reference.Manifest(cmd.Root())
+
+			result.Record(ctx, datum)
+
+			if directory == "" {
+				buffer, e := output.Write(format.Get(ctx), datum)
+				if e != nil {
+					return e
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+				return nil
+			}
+
+			buffer, e := output.Write(output.JSON, datum)
+			if e != nil {
+				return e
+			}
+
+			var filesystem = system.Get(ctx).Filesystem
+
+			if e := filesystem.MkdirAll(directory, 0o755); e != nil {
+				return e
+			}
+
+			return filesystem.WriteFile(filepath.Join(directory, "manifest.json"), buffer.Bytes(), 0o644)
+		},
+	}
+
+	command.Flags().StringVarP(&directory, "directory", "d", "", "the output directory")
+
+	return command
+}
+
+// generate renders one file per command of cmd's tree into the directory.
+func generate(cmd *cobra.Command, directory, extension string, render func(io.Writer, reference.Command) error) error {
+	var filesystem = system.Get(cmd.Context()).Filesystem
+
+	if e := filesystem.MkdirAll(directory, 0o755); e != nil {
+		return e
+	}
+
+	return reference.Manifest(cmd.Root()).Walk(func(command reference.Command) error {
+		var buffer bytes.Buffer
+		if e := render(&buffer, command); e != nil {
+			return e
+		}
+
+		return filesystem.WriteFile(filepath.Join(directory, command.Basename()+extension), buffer.Bytes(), 0o644)
+	})
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:    New,
+		Order:  100,
+		Hidden: true,
+	})
+}
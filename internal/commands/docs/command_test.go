@@ -0,0 +1,94 @@
+package docs_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/harness"
+	"template-go-cli/internal/reference"
+)
+
+// link matches a Markdown link's target.
+var link = regexp.MustCompile(`\]\(\./([^)]+)\)`)
+
+func TestCommand(t *testing.T) {
+	directory := t.TempDir()
+
+	for _, args := range [][]string{
+		{"docs", "markdown", "--directory", filepath.Join(directory, "markdown")},
+		{"docs", "man", "--directory", filepath.Join(directory, "man")},
+		{"docs", "manifest", "--directory", directory},
+	} {
+		if result := harness.Run(t, harness.Options{Args: args, Env: map[string]string{"SOURCE_DATE_EPOCH": "946684800"}}); result.Code != 0 {
+			t.Fatalf("%q: unexpected exit code %d; standard-error:\n%s", args, result.Code, result.Stderr)
+		}
+	}
+
+	content, e := os.ReadFile(filepath.Join(directory, "manifest.json"))
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var manifest reference.Command
+	if e := json.Unmarshal(content, &manifest); e != nil {
+		t.Fatalf("invalid manifest: %v", e)
+	}
+
+	// Exactly one page per documented command is written, and the hidden docs command isn't documented.
+	var pages = map[string]bool{}
+
+	_ = manifest.Walk(func(command reference.Command) error {
+		if command.Name == "docs" {
+			t.Errorf("expected the hidden %q command to be excluded", command.Path)
+		}
+
+		pages[command.Basename()] = true
+
+		return nil
+	})
+
+	for extension, subdirectory := range map[string]string{".md": "markdown", ".1": "man"} {
+		entries, e := os.ReadDir(filepath.Join(directory, subdirectory))
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		if len(entries) != len(pages) {
+			t.Errorf("expected %d %s pages, received %d", len(pages), subdirectory, len(entries))
+		}
+
+		for _, entry := range entries {
+			if !pages[strings.TrimSuffix(entry.Name(), extension)] {
+				t.Errorf("unexpected %s page %q", subdirectory, entry.Name())
+			}
+		}
+	}
+
+	// Every Markdown cross-link resolves to a written page.
+	for page := range pages {
+		content, e := os.ReadFile(filepath.Join(directory, "markdown", page+".md"))
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		for _, match := range link.FindAllStringSubmatch(string(content), -1) {
+			if _, e := os.Stat(filepath.Join(directory, "markdown", match[1])); e != nil {
+				t.Errorf("%q links to the missing page %q", page, match[1])
+			}
+		}
+	}
+
+	if !pages[constants.Name] {
+		t.Fatalf("expected the root's page, received %v", pages)
+	}
+
+	man, e := os.ReadFile(filepath.Join(directory, "man", constants.Name+".1"))
+	if e != nil || !strings.Contains(string(man), `"Jan 2000"`) {
+		t.Fatalf("expected the man page to be dated per SOURCE_DATE_EPOCH: %v\n%s", e, man)
+	}
+}
@@ -0,0 +1,2 @@
+// Package docs provides the hidden, developer-facing reference documentation generation cli sub-command(s).
+package docs
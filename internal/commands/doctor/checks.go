@@ -0,0 +1,180 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/completion"
+	"template-go-cli/internal/config"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/diagnostics"
+	"template-go-cli/internal/paths"
+	"template-go-cli/internal/plugins"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/terminal"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	diagnostics.Register(diagnostics.Check{Name: "config", Run: configuration})
+	diagnostics.Register(diagnostics.Check{Name: "directories", Run: directories})
+	diagnostics.Register(diagnostics.Check{Name: "terminal", Run: capabilities})
+	diagnostics.Register(diagnostics.Check{Name: "plugins", Run: conflicts})
+	diagnostics.Register(diagnostics.Check{Name: "completion", Run: installation})
+	diagnostics.Register(diagnostics.Check{Name: "clock", Run: clock})
+}
+
+// configuration verifies the configuration file, if present, parses.
+func configuration(ctx context.Context, root *cobra.Command) (diagnostics.Status, string) {
+	path, e := config.Path(ctx)
+	if e != nil {
+		return diagnostics.Fail, e.Error()
+	}
+
+	if _, e := system.Get(ctx).Filesystem.Stat(path); e != nil {
+		return diagnostics.Pass, fmt.Sprintf("no configuration file at %q; using defaults", path)
+	}
+
+	if _, e := config.Load(ctx, path); e != nil {
+		return diagnostics.Fail, e.Error()
+	}
+
+	return diagnostics.Pass, fmt.Sprintf("parsed %q", path)
+}
+
+// directories verifies the cache, configuration and state directories -- via the context's filesystem, without
+// creating them -- are writable directories, by creating and removing a probe file within each; permission bits alone
+// don't account for ownership, ACLs or read-only mounts. Missing directories are a warning, as they're created upon
+// first use.
+func directories(ctx context.Context, root *cobra.Command) (diagnostics.Status, string) {
+	var status = diagnostics.Pass
+
+	var messages []string
+
+	for _, resolve := range []func(context.Context) (string, error){paths.Cache, paths.Config, paths.State} {
+		directory, e := resolve(ctx)
+		if e != nil {
+			return diagnostics.Fail, e.Error()
+		}
+
+		filesystem := system.Get(ctx).Filesystem
+
+		information, e := filesystem.Stat(directory)
+
+		switch {
+		case errors.Is(e, fs.ErrNotExist):
+			status = diagnostics.Warn
+			messages = append(messages, fmt.Sprintf("%q doesn't exist", directory))
+
+			continue
+
+		case e != nil:
+			return diagnostics.Fail, fmt.Sprintf("unable to inspect %q: %s", directory, e)
+
+		case !information.IsDir():
+			return diagnostics.Fail, fmt.Sprintf("%q is not a directory", directory)
+		}
+
+		probe := filepath.Join(directory, fmt.Sprintf(".%s-doctor-%d", constants.Name, os.Getpid()))
+
+		if e := filesystem.WriteFile(probe, nil, 0o600); e != nil {
+			return diagnostics.Fail, fmt.Sprintf("%q is not writable: %s", directory, e)
+		}
+
+		if e := filesystem.Remove(probe); e != nil {
+			return diagnostics.Fail, fmt.Sprintf("unable to remove %q: %s", probe, e)
+		}
+
+		messages = append(messages, fmt.Sprintf("%q is writable", directory))
+	}
+
+	return status, strings.Join(messages, "; ")
+}
+
+// capabilities reports the terminal's capabilities. A terminal without a "TERM" value is a warning.
+func capabilities(ctx context.Context, root *cobra.Command) (diagnostics.Status, string) {
+	var describe = func(file *os.File) string {
+		if terminal.Interactive(file) {
+			return "terminal"
+		}
+
+		return "redirected"
+	}
+
+	term := system.Get(ctx).Getenv("TERM")
+
+	message := fmt.Sprintf("stdout: %s, stderr: %s, TERM: %q, color: %t", describe(os.Stdout), describe(os.Stderr), term, terminal.Color(ctx, os.Stdout))
+
+	if terminal.Interactive(os.Stdout) && term == "" {
+		return diagnostics.Warn, message
+	}
+
+	return diagnostics.Pass, message
+}
+
+// conflicts reports plugins that conflict with built-in commands or shadow one another.
+func conflicts(ctx context.Context, root *cobra.Command) (diagnostics.Status, string) {
+	var problems []string
+
+	discovered := plugins.Discover(ctx, plugins.Builtins(root))
+	for _, plugin := range discovered {
+		if plugin.Conflict {
+			problems = append(problems, fmt.Sprintf("%q conflicts with a built-in command", plugin.Path))
+		}
+
+		for _, shadowed := range plugin.Shadowed {
+			problems = append(problems, fmt.Sprintf("%q is shadowed by %q", shadowed, plugin.Path))
+		}
+	}
+
+	if len(problems) > 0 {
+		return diagnostics.Warn, strings.Join(problems, "; ")
+	}
+
+	return diagnostics.Pass, fmt.Sprintf("%d plugin(s) discovered without conflicts", len(discovered))
+}
+
+// installation verifies a shell completion script is installed; see [completion.Installed].
+func installation(ctx context.Context, root *cobra.Command) (diagnostics.Status, string) {
+	targets := completion.Installed(ctx)
+	if len(targets) == 0 {
+		return diagnostics.Warn, fmt.Sprintf("no completion script found; see \"%s completion install --help\"", constants.Name)
+	}
+
+	var messages []string
+	for _, target := range targets {
+		messages = append(messages, fmt.Sprintf("%s: %q", target.Shell, target.Script))
+	}
+
+	return diagnostics.Pass, fmt.Sprintf("installed for %s", strings.Join(messages, ", "))
+}
+
+// layouts are the accepted build date formats: goreleaser's RFC3339 and the Makefile's "date +%Y-%m-%d:%H-%M-%S".
+var layouts = []string{time.RFC3339, "2006-01-02:15-04-05"}
+
+// clock verifies the system clock isn't behind the executable's build date.
+func clock(ctx context.Context, root *cobra.Command) (diagnostics.Status, string) {
+	for _, layout := range layouts {
+		date, e := time.Parse(layout, build.Date)
+		if e != nil {
+			continue
+		}
+
+		// Tolerate minor skew (and timezone-less build dates).
+		if skew := date.Sub(system.Get(ctx).Now()); skew > 24*time.Hour {
+			return diagnostics.Fail, fmt.Sprintf("system clock is %s behind the build date (%s)", skew.Round(time.Minute), build.Date)
+		}
+
+		return diagnostics.Pass, fmt.Sprintf("system clock is consistent with the build date (%s)", build.Date)
+	}
+
+	return diagnostics.Warn, fmt.Sprintf("unable to verify clock skew; unrecognized build date %q", build.Date)
+}
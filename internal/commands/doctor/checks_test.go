@@ -0,0 +1,139 @@
+package doctor
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/diagnostics"
+	"template-go-cli/internal/system"
+)
+
+// unwritable is a [system.Filesystem] whose writes are denied, regardless of the user's privileges.
+type unwritable struct {
+	system.Filesystem
+}
+
+func (unwritable) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+}
+
+func TestDirectories(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires XDG base directories")
+	}
+
+	// create creates the cache, configuration and state directories.
+	create := func(t *testing.T, cache, config, state string) {
+		for _, directory := range []string{cache, config, state} {
+			if e := os.MkdirAll(directory, 0o700); e != nil {
+				t.Fatal(e)
+			}
+		}
+	}
+
+	tests := []struct {
+		name       string
+		prepare    func(t *testing.T, cache, config, state string)
+		filesystem system.Filesystem
+		status     diagnostics.Status
+		message    string
+	}{
+		{
+			name:    "writable",
+			prepare: create,
+			status:  diagnostics.Pass,
+			message: "is writable",
+		},
+		{
+			name:    "missing",
+			status:  diagnostics.Warn,
+			message: "doesn't exist",
+		},
+		{
+			name: "file",
+			prepare: func(t *testing.T, cache, config, state string) {
+				if e := os.MkdirAll(filepath.Dir(cache), 0o700); e != nil {
+					t.Fatal(e)
+				}
+
+				if e := os.WriteFile(cache, nil, 0o600); e != nil {
+					t.Fatal(e)
+				}
+			},
+			status:  diagnostics.Fail,
+			message: "is not a directory",
+		},
+		{
+			name: "read-only",
+			prepare: func(t *testing.T, cache, config, state string) {
+				if e := os.MkdirAll(filepath.Dir(cache), 0o700); e != nil {
+					t.Fatal(e)
+				}
+
+				if e := os.Mkdir(cache, 0o500); e != nil {
+					t.Fatal(e)
+				}
+			},
+			status:  diagnostics.Fail,
+			message: "is not writable: ",
+		},
+		{
+			name:       "denied",
+			prepare:    create,
+			filesystem: unwritable{system.Host().Filesystem},
+			status:     diagnostics.Fail,
+			message:    "is not writable: open",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Permission bits don't restrict the superuser, for whom the directory remains writable.
+			if test.name == "read-only" && os.Geteuid() == 0 {
+				t.Skip("requires an unprivileged user")
+			}
+
+			home := t.TempDir()
+
+			variables := map[string]string{
+				"HOME":            home,
+				"XDG_CACHE_HOME":  filepath.Join(home, ".cache"),
+				"XDG_CONFIG_HOME": filepath.Join(home, ".config"),
+				"XDG_STATE_HOME":  filepath.Join(home, ".local", "state"),
+			}
+
+			cache := filepath.Join(variables["XDG_CACHE_HOME"], constants.Name)
+			config := filepath.Join(variables["XDG_CONFIG_HOME"], constants.Name)
+			state := filepath.Join(variables["XDG_STATE_HOME"], constants.Name)
+
+			if test.prepare != nil {
+				test.prepare(t, cache, config, state)
+			}
+
+			ctx := system.With(context.Background(), system.System{Env: system.Environment(variables), Filesystem: test.filesystem})
+
+			status, message := directories(ctx, nil)
+			if status != test.status || !strings.Contains(message, test.message) {
+				t.Fatalf("expected %s (%q), received %s (%q)", test.status, test.message, status, message)
+			}
+
+			// The check mustn't create any directories.
+			if _, e := os.Stat(config); test.prepare == nil && !os.IsNotExist(e) {
+				t.Fatalf("expected %q not to be created", config)
+			}
+
+			// Nor leave its probe files behind.
+			for _, directory := range []string{cache, config, state} {
+				if entries, _ := os.ReadDir(directory); len(entries) > 0 {
+					t.Fatalf("expected %q to be left empty, received %d entries", directory, len(entries))
+				}
+			}
+		})
+	}
+}
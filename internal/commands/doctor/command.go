@@ -0,0 +1,75 @@
+package doctor
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/diagnostics"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the doctor command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose the cli's environment",
+		Long:  "Runs a set of environment checks -- configuration, directories, terminal, plugins, shell completion and clock -- and reports a pass, warn or fail status per check. The command exits non-zero if any check fails.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Run all diagnostics"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s doctor", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Run all diagnostics, rendering the results as yaml"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s doctor --output yaml", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Running doctor command")
+
+			var datum = diagnostics.Run(ctx, cmd.Root())
+
+			result.Record(ctx, datum)
+
+			buffer, e := output.Write(format.Get(ctx), datum)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			var failures []string
+			for _, result := range datum {
+				if result.Status == diagnostics.Fail {
+					failures = append(failures, result.Name)
+				}
+			}
+
+			if len(failures) > 0 {
+				return exceptions.New(exceptions.Validation, "%d of %d check(s) failed", len(failures), len(datum)).WithDetail("failures", failures)
+			}
+
+			return nil
+		},
+	}
+
+	return command
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 100,
+	})
+}
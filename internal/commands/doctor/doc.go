@@ -0,0 +1,2 @@
+// Package doctor provides the environment diagnostics cli sub-command.
+package doctor
@@ -3,84 +3,101 @@ package example
 import (
 	"fmt"
 	"log/slog"
-	"os"
+	"strings"
+	"template-go-cli/internal/commands/registry"
 	"template-go-cli/internal/constants"
 	"template-go-cli/internal/flags/format"
 	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
 	"template-go-cli/internal/types/level"
-	"strings"
 
 	"template-go-cli/internal/types/output"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
-var (
-	name string
-)
+// New returns the example command.
+func New() *cobra.Command {
+	var (
+		name string
+	)
+
+	var command = &cobra.Command{
+		Use:        "example",
+		Aliases:    []string{},
+		SuggestFor: nil,
+		Short:      "An example command and template",
+		Long:       "The example's command long-description -- value should be in full sentences, and can span multiple lines.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# General command usage"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s example --name \"test-value\"", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Extended usage demonstrating configuration of default(s)"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s example --name \"test-value\" --output json", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Display help information and command usage"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s example --help", constants.Name)),
+		}, "\n"),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			// Update logger instance to include command's explicitly set flags.
+			var flags []any
+			cmd.Flags().Visit(func(flag *pflag.Flag) {
+				flags = append(flags, slog.String(flag.Name, flag.Value.String()))
+			})
+
+			var logger = logging.Get(ctx)
+			log := logger.With(slog.Group("flags", flags...))
+
+			ctx = logging.With(ctx, log)
+
+			cmd.SetContext(ctx)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Running example command")
+
+			var datum = map[string]string{
+				"name": name,
+			}
+
+			result.Record(ctx, datum)
+
+			buffer, e := output.Write(format.Get(ctx), datum)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+		TraverseChildren: true,
+		SilenceErrors:    true,
+	}
 
-var Command = &cobra.Command{
-	Use:        "example",
-	Aliases:    []string{},
-	SuggestFor: nil,
-	GroupID:    "examples",
-	Short:      "An example command and template",
-	Long:       "The example's command long-description -- value should be in full sentences, and can span multiple lines.",
-	Example: strings.Join([]string{
-		fmt.Sprintf("  %s", "# General command usage"),
-		fmt.Sprintf("  %s", fmt.Sprintf("%s example --name \"test-value\"", constants.Name)),
-		"",
-		fmt.Sprintf("  %s", "# Extended usage demonstrating configuration of default(s)"),
-		fmt.Sprintf("  %s", fmt.Sprintf("%s example --name \"test-value\" --output json", constants.Name)),
-		"",
-		fmt.Sprintf("  %s", "# Display help information and command usage"),
-		fmt.Sprintf("  %s", fmt.Sprintf("%s example --help", constants.Name)),
-	}, "\n"),
-	PreRunE: func(cmd *cobra.Command, args []string) error {
-		ctx := cmd.Context()
-
-		// Update logger instance to include command's flags.
-		var logger = logging.Get(ctx)
-		log := logger.With(slog.Any("flags", cmd.Flags()))
-		slog.SetDefault(log)
-
-		ctx = logging.With(ctx, log)
-
-		cmd.SetContext(ctx)
-
-		return nil
-	},
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := cmd.Context()
-
-		var log = logging.Get(ctx)
-
-		log.Log(ctx, level.Trace.Level(), "Running example command")
-
-		var datum = map[string]string{
-			"name": name,
-		}
+	flags := command.Flags()
 
-		buffer, e := output.Write(format.Get(ctx), datum)
-		if e != nil {
-			return e
+	flags.StringVarP(&name, "name", "n", "", "a required example named-string-flag")
+	if e := command.MarkFlagRequired("name"); e != nil {
+		if exception := command.Help(); exception != nil {
+			panic(exception)
 		}
+	}
 
-		fmt.Fprintf(os.Stdout, "%s", buffer.String())
-
-		return nil
-	},
-	TraverseChildren: true,
-	SilenceErrors:    true,
+	return command
 }
 
 func init() {
-	flags := Command.Flags()
-
-	flags.StringVarP(&name, "name", "n", "", "a required example named-string-flag")
-	if e := Command.MarkFlagRequired("name"); e != nil {
-		if exception := Command.Help(); exception != nil {
-			panic(exception)
-		}
-	}
+	registry.Register(registry.Registration{
+		New:   New,
+		Group: &cobra.Group{ID: "examples", Title: "Example Commands"},
+	})
 }
@@ -0,0 +1,33 @@
+package example_test
+
+import (
+	"testing"
+
+	"template-go-cli/internal/harness"
+)
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{name: "json", args: []string{"example", "--name", "test-value", "--output", "json"}},
+		{name: "yaml", args: []string{"example", "--name", "test-value", "--output", "yaml"}},
+		{name: "default", args: []string{"example", "-n", "test-value"}},
+		{name: "missing-name", args: []string{"example"}, code: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := harness.Run(t, harness.Options{Args: test.args})
+
+			if result.Code != test.code {
+				t.Fatalf("expected exit code %d, received %d; standard-error:\n%s", test.code, result.Code, result.Stderr)
+			}
+
+			harness.Golden(t, test.name+".stdout", result.Stdout)
+			harness.Golden(t, test.name+".stderr", result.Stderr)
+		})
+	}
+}
@@ -1,21 +1,382 @@
 package commands
 
 import (
-	"template-go-cli/internal/commands/example"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"syscall"
+	"time"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/crash"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/plugins"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/session"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/output"
+	"template-go-cli/internal/update"
 
 	"github.com/spf13/cobra"
 )
 
-// Execute runs the root command and handles any CLI execution exception. Additionally,
-// all child command(s) are added to the root command.
+// Execute runs the root command with the process' command-line arguments and handles any CLI execution
+// exception; see [New] for assembling the root command.
+//
+// The command's context is cancelled upon the first SIGINT or SIGTERM -- exiting with the [exceptions.Cancelled]
+// code -- or once the global "--timeout" elapses -- exiting with the [exceptions.Timeout] code; a second signal
+// forcefully exits the process.
+//
+// Errors are classified via [exceptions.From], reported to standard-error, and mapped to the
+// exception's exit code. Panics are recovered and written to a crash report; see [crash.Report].
 func Execute(root *cobra.Command) {
-	examples := &cobra.Group{ID: "examples", Title: "Example Commands"}
+	if code := Run(root, os.Args[1:]); code != 0 {
+		os.Exit(code)
+	}
+}
+
+// New returns a new root command (see [Root]) for the system s, with the execution-level persistent flags and every
+// registered child command (see [registry.Build]) attached. External plugins are attached upon execution, as
+// required by its arguments; see [plugins.Resolve]. Each call returns an independent command tree, including its
+// flags' state, so that roots may be executed concurrently.
+func New(s system.System) (*cobra.Command, error) {
+	var root = Root()
+
+	var timeout time.Duration
+
+	root.PersistentFlags().DurationVar(&timeout, "timeout", 0, "bound the command's total runtime (e.g. \"30s\", \"5m\"); zero disables the timeout")
+	root.PersistentFlags().String("failure-log", "", "write buffered trace-level logs to the given file, rather than standard-error, when a command fails")
+
+	root.SilenceErrors = true
+	root.SilenceUsage = true
+
+	root.SetFlagErrorFunc(usage)
+
+	// The root's context carries the system into each of its executions; see [execute].
+	root.SetContext(system.With(context.Background(), s))
+
+	if e := registry.Build(root.Context(), root); e != nil {
+		return nil, e
+	}
+
+	classify(root)
+
+	// Apply the timeout once flags are parsed, prior to the root's own persistent pre-run bootstrap. Required flags and
+	// flag groups are validated beforehand -- cobra validates them only after the pre-runs -- as usage errors.
+	var bootstrap = root.PersistentPreRunE
+
+	root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if e := cmd.ValidateRequiredFlags(); e != nil {
+			return usage(cmd, e)
+		}
+
+		if e := cmd.ValidateFlagGroups(); e != nil {
+			return usage(cmd, e)
+		}
 
-	root.AddGroup(examples)
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeoutCause(cmd.Context(), timeout, exceptions.New(exceptions.Timeout, "command exceeded timeout of %s", timeout).WithHint("increase the \"--timeout\" value"))
 
-	root.AddCommand(example.Command)
+			// The timer is released along with the execution's context; see [execute].
+			context.AfterFunc(ctx, cancel)
 
-	if e := root.Execute(); e != nil {
-		cobra.CheckErr(e)
+			cmd.SetContext(ctx)
+		}
+
+		if bootstrap == nil {
+			return nil
+		}
+
+		return bootstrap(cmd, args)
 	}
+
+	return root, nil
+}
+
+// Run is like [Execute] but executes the root command with args, returning the exit code rather than terminating
+// the process.
+func Run(root *cobra.Command, args []string) int {
+	ctx, cancel, detach := interruptible(inherit(context.Background(), root))
+
+	// Check for a newer release in the background; the notice, if any, is printed once the command completes.
+	notifier := update.Notify(ctx, root.OutOrStdout(), root.ErrOrStderr())
+
+	// Record the command's result datum, if any, to suppress the notice following structured output.
+	ctx, recorder := result.With(ctx)
+
+	command, ring, e := execute(ctx, root, args, detach)
+
+	cancel()
+
+	notify(root, command, recorder, notifier)
+
+	if e != nil {
+		if exceptions.From(e).Kind != exceptions.Usage {
+			flush(root, ring)
+		}
+
+		return report(root, command, e)
+	}
+
+	return 0
+}
+
+// Invoke is like [Run], but for in-process callers: the execution is bound to ctx rather than the process' signals,
+// the update notifier is skipped, and errors are returned as an [exceptions.Exception] rather than reported. The
+// command's result datum is returned -- even alongside an error -- if it recorded one; see [result.Record].
+func Invoke(ctx context.Context, root *cobra.Command, args []string) (any, error) {
+	ctx, recorder := result.With(ctx)
+
+	// In-process callers own the process' signal handling.
+	_, _, e := execute(ctx, root, args, func() {})
+
+	datum, _ := recorder.Value()
+
+	if e != nil {
+		return datum, exceptions.From(e)
+	}
+
+	return datum, nil
+}
+
+// Nested executes a new root command -- for the same system and standard streams as root -- with args from within
+// an executing command (e.g. an interactive shell), returning the exit code. The execution is bound to ctx, and is
+// additionally cancelled upon SIGINT or SIGTERM without affecting the enclosing execution; see [session.Controls].
+// Unlike [Run], the update notifier is skipped.
+func Nested(ctx context.Context, root *cobra.Command, args []string) int {
+	nested, e := New(system.Get(ctx))
+	if e != nil {
+		return report(root, root, exceptions.Wrap(exceptions.Internal, e, "unable to build command tree"))
+	}
+
+	nested.SetIn(root.InOrStdin())
+	nested.SetOut(root.OutOrStdout())
+	nested.SetErr(root.ErrOrStderr())
+
+	ctx, cancel, detach := interruptible(ctx)
+
+	command, ring, e := execute(ctx, nested, args, detach)
+
+	cancel()
+
+	if e != nil {
+		if exceptions.From(e).Kind != exceptions.Usage {
+			flush(nested, ring)
+		}
+
+		return report(nested, command, e)
+	}
+
+	return 0
+}
+
+// execute runs the root command with args and ctx, returning the executed command, the ring of its buffered log
+// records and its error, if any. The command's [session.Controls] detach via the given function.
+func execute(ctx context.Context, root *cobra.Command, args []string, detach func()) (*cobra.Command, *logging.Ring, error) {
+	// Release the execution's resources (e.g. its timeout) upon return.
+	ctx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	ctx = inherit(ctx, root)
+
+	ctx = session.With(ctx, session.Controls{
+		Run: func(ctx context.Context, args []string) int {
+			return Nested(ctx, root, args)
+		},
+		Detach: detach,
+	})
+
+	// Retain the most recent log records -- at trace level -- for failure and crash reports.
+	ring := logging.NewRing(1000, 1<<20)
+
+	ctx = logging.WithRing(ctx, ring)
+
+	// Seed the context with a logger of the execution's own, writing to the root's standard-error (and the ring),
+	// rather than the process' default logger; the root command's bootstrap replaces it with the configured one.
+	options := &slog.HandlerOptions{ReplaceAttr: logging.Replacements}
+
+	ctx = logging.With(ctx, slog.New(logging.Tee(slog.NewTextHandler(root.ErrOrStderr(), options), ring.Handler(options))))
+
+	plugins.Resolve(ctx, root, args)
+
+	root.SetArgs(args)
+
+	command, e := run(ctx, root, args, ring)
+
+	if parsing(e) {
+		e = usage(command, e)
+	}
+
+	// Prefer the cancellation's cause (signal or timeout) over the command's own -- typically wrapped -- context error.
+	// A command that completed successfully regardless of the cancellation isn't reported as cancelled.
+	if current := command.Context(); e != nil && current != nil && current.Err() != nil {
+		cause := context.Cause(current)
+
+		logging.Get(current).WarnContext(context.WithoutCancel(current), "Command cancelled", slog.String("cause", cause.Error()))
+
+		e = cause
+	}
+
+	return command, ring, e
+}
+
+// inherit returns a copy of ctx holding the [system.System] carried by the root's context, if any; see [New].
+func inherit(ctx context.Context, root *cobra.Command) context.Context {
+	if current := root.Context(); current != nil {
+		return system.With(ctx, system.Get(current))
+	}
+
+	return ctx
+}
+
+// notify prints the notifier's update-available notice to standard-error, unless the command produced structured
+// output (i.e. recorded a result datum; see [result.Record]) or structured output was explicitly requested, or the
+// command is hidden, a shell-completion request, or "self-update" itself.
+func notify(root, command *cobra.Command, recorder *result.Recorder, notifier *update.Notifier) {
+	if notifier == nil || structured(root) != nil || command.Hidden || command.Name() == "self-update" {
+		return
+	}
+
+	if _, recorded := recorder.Value(); recorded {
+		return
+	}
+
+	if name := command.Name(); name == cobra.ShellCompRequestCmd || name == cobra.ShellCompNoDescRequestCmd {
+		return
+	}
+
+	if notice := notifier.Notice(250 * time.Millisecond); notice != "" {
+		fmt.Fprintf(root.ErrOrStderr(), "\n%s\n", notice)
+	}
+}
+
+// flush writes the ring's buffered log records to the "--failure-log" file, or standard-error if there isn't one. Only
+// the records below the "--log-level" -- those that weren't already emitted -- are written to standard-error.
+func flush(root *cobra.Command, ring *logging.Ring) {
+	path, _ := root.PersistentFlags().GetString("failure-log")
+	if path == "" {
+		var emitted slog.Leveler
+		if flag := root.PersistentFlags().Lookup("log-level"); flag != nil {
+			emitted, _ = flag.Value.(slog.Leveler)
+		}
+
+		if e := ring.Flush(root.ErrOrStderr(), emitted); e != nil {
+			fmt.Fprintf(os.Stderr, "Warning: unable to write buffered log records: %s\n", e)
+		}
+
+		return
+	}
+
+	file, e := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if e != nil {
+		fmt.Fprintf(root.ErrOrStderr(), "Warning: unable to open failure log: %s\n", e)
+
+		return
+	}
+
+	defer file.Close()
+
+	if e := ring.Flush(file, nil); e != nil {
+		fmt.Fprintf(root.ErrOrStderr(), "Warning: unable to write failure log: %s\n", e)
+	}
+}
+
+// run executes the root command, recovering from any panic. A recovered panic is written to a crash report -- with
+// the execution's (redacted) args and ring's log records -- and returned as an [exceptions.Internal] exception
+// referencing the report.
+func run(ctx context.Context, root *cobra.Command, args []string, ring *logging.Ring) (command *cobra.Command, e error) {
+	command = root
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		exception := exceptions.New(exceptions.Internal, "%s crashed unexpectedly", root.Name())
+
+		path, failure := crash.New(ctx, recovered, debug.Stack(), args, ring.Entries()).Write(ctx)
+		if failure != nil {
+			exception.Cause = fmt.Errorf("%v (unable to write crash report: %w)", recovered, failure)
+		} else {
+			exception.WithHint("a crash report was written to %q; please include it when reporting the issue", path).WithDetail("report", path)
+		}
+
+		e = exception
+	}()
+
+	return root.ExecuteContextC(ctx)
+}
+
+// interruptible returns a copy of parent that's cancelled upon receipt of SIGINT or SIGTERM. A subsequent signal
+// forcefully exits the process with the [exceptions.Cancelled] exit code. The first returned function releases the
+// signal handler; the second stops the handler without cancelling the context (see [session.Controls]).
+func interruptible(parent context.Context) (context.Context, context.CancelFunc, func()) {
+	ctx, cancel := context.WithCancelCause(parent)
+
+	signals := make(chan os.Signal, 2)
+	stopped := make(chan struct{})
+
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case s := <-signals:
+			cancel(exceptions.New(exceptions.Cancelled, "received %s signal", s))
+		case <-stopped:
+			return
+		}
+
+		select {
+		case s := <-signals:
+			fmt.Fprintf(os.Stderr, "Error: received %s signal; forcing exit\n", s)
+
+			os.Exit(exceptions.Cancelled.Code())
+		case <-stopped:
+			return
+		}
+	}()
+
+	detach := func() { signal.Stop(signals) }
+
+	return ctx, func() {
+		signal.Stop(signals)
+		close(stopped)
+		cancel(nil)
+	}, detach
+}
+
+// report writes the error to standard-error and returns the error's exit code.
+func report(root, command *cobra.Command, e error) int {
+	exception := exceptions.From(e)
+	if exception.Kind == exceptions.Usage && exception.Hint == "" {
+		exception.WithHint("see \"%s --help\" for usage", command.CommandPath())
+	}
+
+	// Fallback to the raw error if reporting itself fails.
+	if failure := exceptions.Report(root.ErrOrStderr(), structured(root), exception); failure != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", e)
+	}
+
+	return exception.Code()
+}
+
+// structured returns the output format if it was explicitly requested via the "--output" flag; otherwise nil,
+// in which case errors are reported as human-readable text.
+func structured(root *cobra.Command) *output.Type {
+	flag := root.PersistentFlags().Lookup("output")
+	if flag == nil || !flag.Changed {
+		return nil
+	}
+
+	format, valid := flag.Value.(*output.Type)
+	if !valid {
+		return nil
+	}
+
+	return format
 }
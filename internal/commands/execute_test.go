@@ -0,0 +1,153 @@
+package commands_test
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"template-go-cli/internal/commands"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/crash"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/redact"
+	"template-go-cli/internal/system"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+)
+
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		name   string
+		run    func(cmd *cobra.Command, args []string) error
+		code   int
+		stderr string
+	}{
+		{
+			name: "exceeded",
+			run: func(cmd *cobra.Command, args []string) error {
+				<-cmd.Context().Done()
+
+				return cmd.Context().Err()
+			},
+			code:   exceptions.Timeout.Code(),
+			stderr: "command exceeded timeout of 10ms",
+		},
+		{
+			name: "completed",
+			run: func(cmd *cobra.Command, args []string) error {
+				<-cmd.Context().Done()
+
+				return nil
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root, e := commands.New(system.Host())
+			if e != nil {
+				t.Fatal(e)
+			}
+
+			var stdout, stderr bytes.Buffer
+
+			root.SetOut(&stdout)
+			root.SetErr(&stderr)
+
+			root.AddCommand(&cobra.Command{Use: "block", RunE: test.run})
+
+			if code := commands.Run(root, []string{"--timeout", (10 * time.Millisecond).String(), "block"}); code != test.code {
+				t.Fatalf("expected exit code %d, received %d; standard-error:\n%s", test.code, code, stderr.String())
+			}
+
+			if !strings.Contains(stderr.String(), test.stderr) {
+				t.Fatalf("expected standard-error to contain %q; received:\n%s", test.stderr, stderr.String())
+			}
+
+			if test.code == 0 && strings.Contains(stderr.String(), "Command cancelled") {
+				t.Fatalf("expected a successful command not to be reported as cancelled; received:\n%s", stderr.String())
+			}
+		})
+	}
+}
+
+func TestCrash(t *testing.T) {
+	home := t.TempDir()
+
+	root, e := commands.New(system.System{Env: system.Environment(map[string]string{
+		"HOME":                                   home,
+		"XDG_CACHE_HOME":                         filepath.Join(home, ".cache"),
+		"XDG_CONFIG_HOME":                        filepath.Join(home, ".config"),
+		"XDG_STATE_HOME":                         filepath.Join(home, ".local", "state"),
+		constants.Prefix + "_NO_UPDATE_NOTIFIER": "1",
+	})})
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	root.SetOut(&stdout)
+	root.SetErr(&stderr)
+
+	crasher := &cobra.Command{
+		Use: "crash",
+		Run: func(cmd *cobra.Command, args []string) {
+			logging.Get(cmd.Context()).InfoContext(cmd.Context(), "Crashing", slog.String("token", "hunter2"), slog.String("stage", "run"))
+
+			panic("boom")
+		},
+	}
+
+	crasher.Flags().String("token", "", "a secret token")
+
+	root.AddCommand(crasher)
+
+	if code := commands.Run(root, []string{"crash", "--token", "swordfish"}); code != exceptions.Internal.Code() {
+		t.Fatalf("expected exit code %d, received %d; standard-error:\n%s", exceptions.Internal.Code(), code, stderr.String())
+	}
+
+	if !strings.Contains(stderr.String(), "a crash report was written to") {
+		t.Fatalf("expected standard-error to reference the crash report; received:\n%s", stderr.String())
+	}
+
+	reports, e := filepath.Glob(filepath.Join(home, ".cache", constants.Name, "crashes", "crash-*.yaml"))
+	if e != nil || len(reports) != 1 {
+		t.Fatalf("expected a single crash report, received %q (%v)", reports, e)
+	}
+
+	content, e := os.ReadFile(reports[0])
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if strings.Contains(string(content), "hunter2") || strings.Contains(string(content), "swordfish") {
+		t.Fatalf("expected the crash report's secrets to be redacted:\n%s", content)
+	}
+
+	var report crash.Report
+	if e := yaml.Unmarshal(content, &report); e != nil {
+		t.Fatalf("unable to decode the crash report: %v", e)
+	}
+
+	if report.Panic != "boom" || !strings.Contains(report.Stack, "panic") {
+		t.Fatalf("expected the report to include the panic and its stack, received %q:\n%s", report.Panic, report.Stack)
+	}
+
+	if expected := []string{"crash", "--token", redact.Redacted}; !slices.Equal(report.Args, expected) {
+		t.Fatalf("expected the arguments %q, received %q", expected, report.Args)
+	}
+
+	if !slices.ContainsFunc(report.Logs, func(record string) bool {
+		return strings.Contains(record, "Crashing") && strings.Contains(record, "token="+redact.Redacted) && strings.Contains(record, "stage=run")
+	}) {
+		t.Fatalf("expected the report to include the redacted log record, received:\n%s", strings.Join(report.Logs, "\n"))
+	}
+}
@@ -0,0 +1,38 @@
+package generate
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the generate command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "generate",
+		Short: "Generate source code for contributors",
+		Long:  "Developer-facing code generators that keep contributions consistent with the repository's house style. Commands must be run from a checkout of the repository.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Generate a new sub-command"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s generate command list-items --parent plugin", constants.Name)),
+		}, "\n"),
+		Args:             cobra.NoArgs,
+		TraverseChildren: true,
+	}
+
+	command.AddCommand(subcommand())
+
+	return command
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:    New,
+		Order:  100,
+		Hidden: true,
+	})
+}
@@ -0,0 +1,2 @@
+// Package generate provides the developer-facing code generation cli sub-command(s).
+package generate
@@ -0,0 +1,92 @@
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/scaffold"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// subcommand returns the "generate command" sub-command.
+func subcommand() *cobra.Command {
+	var (
+		options   scaffold.Options
+		directory string
+	)
+
+	var command = &cobra.Command{
+		Use:   "command <name>",
+		Short: "Generate a new sub-command package",
+		Long:  "Generates a new command package -- doc.go, command.go, and a golden-file command_test.go along with its testdata -- modeled after the example command, and registers it by adding a blank import to \"internal/commands/imports.go\".",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Generate a top-level command"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s generate command greet", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Generate a nested command placed in a help-group"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s generate command inspect --parent plugin --group diagnostics", constants.Name)),
+		}, "\n"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			options.Name = args[0]
+
+			log.Log(ctx, level.Trace.Level(), "Generating command", "name", options.Name, "parent", options.Parent, "group", options.Group)
+
+			// The generated command's registration must resolve its parent; otherwise, no command tree could be built.
+			if parent := strings.Fields(options.Parent); len(parent) > 0 {
+				command, remaining, e := cmd.Root().Find(parent)
+				if e != nil || len(remaining) > 0 || command == cmd.Root() {
+					return exceptions.New(exceptions.Validation, "unknown parent command %q", options.Parent).WithHint("see \"%s --help\" for the available commands", cmd.Root().CommandPath())
+				}
+			}
+
+			files, e := scaffold.Generate(system.Get(ctx).Filesystem, directory, options)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "unable to generate command")
+			}
+
+			if e := scaffold.Write(system.Get(ctx).Filesystem, directory, files); e != nil {
+				if errors.Is(e, fs.ErrExist) {
+					return exceptions.Wrap(exceptions.Conflict, e, "command package already exists")
+				}
+
+				return e
+			}
+
+			result.Record(ctx, files)
+
+			buffer, e := output.Write(format.Get(ctx), files)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVar(&options.Parent, "parent", "", "the space-separated parent command path (e.g. \"plugin\")")
+	flags.StringVar(&options.Group, "group", "", "the help-group identifier to place the command in")
+	flags.StringVar(&options.Title, "title", "", "the help-group's title (defaults to \"<Group> Commands\")")
+	flags.StringVarP(&directory, "directory", "C", ".", "the repository's root directory")
+
+	return command
+}
@@ -0,0 +1,63 @@
+package generate_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/harness"
+)
+
+func TestSubcommand(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stderr string
+		files  []string
+	}{
+		{name: "top-level", args: []string{"greet"}, files: []string{"internal/commands/greet/command.go", "internal/commands/greet/testdata/json.stdout.golden"}},
+		{name: "nested", args: []string{"inspect", "--parent", "plugin"}, files: []string{"internal/commands/plugin/inspect/command.go"}},
+		{name: "unknown-parent", args: []string{"inspect", "--parent", "plugn"}, code: 3, stderr: `unknown parent command "plugn"`},
+		{name: "non-command-parent", args: []string{"inspect", "--parent", "plugin extra"}, code: 3, stderr: `unknown parent command "plugin extra"`},
+		{name: "keyword", args: []string{"func"}, code: 3, stderr: `package name "func" is a go keyword`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+
+			for path, content := range map[string]string{"go.mod": "module example.com/tool\n", "internal/commands/imports.go": "package commands\n\nimport (\n)\n"} {
+				if e := os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0o755); e != nil {
+					t.Fatal(e)
+				}
+
+				if e := os.WriteFile(filepath.Join(root, path), []byte(content), 0o644); e != nil {
+					t.Fatal(e)
+				}
+			}
+
+			result := harness.Run(t, harness.Options{Args: append([]string{"generate", "command", "-C", root}, test.args...)})
+
+			if result.Code != test.code {
+				t.Fatalf("expected exit code %d, received %d; standard-error:\n%s", test.code, result.Code, result.Stderr)
+			}
+
+			if !strings.Contains(result.Stderr, test.stderr) {
+				t.Fatalf("expected standard-error to contain %q; received:\n%s", test.stderr, result.Stderr)
+			}
+
+			for _, path := range test.files {
+				if _, e := os.Stat(filepath.Join(root, path)); e != nil {
+					t.Errorf("expected %q to be generated: %v", path, e)
+				}
+			}
+
+			// Nothing is written upon failure.
+			if entries, _ := os.ReadDir(filepath.Join(root, "internal", "commands")); test.code != 0 && len(entries) != 1 {
+				t.Fatalf("expected no command package to be written, received %d entries", len(entries))
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package commands
+
+// Command packages self-register with the registry from their init function(s); see [registry.Register]. The
+// following import block is maintained by the "generate command" sub-command -- keep one import per line.
+import (
+	_ "template-go-cli/internal/commands/changelog"
+	_ "template-go-cli/internal/commands/completion"
+	_ "template-go-cli/internal/commands/docs"
+	_ "template-go-cli/internal/commands/doctor"
+	_ "template-go-cli/internal/commands/example"
+	_ "template-go-cli/internal/commands/generate"
+	_ "template-go-cli/internal/commands/lint"
+	_ "template-go-cli/internal/commands/plugin"
+	_ "template-go-cli/internal/commands/rebrand"
+	_ "template-go-cli/internal/commands/release"
+	_ "template-go-cli/internal/commands/selfupdate"
+	_ "template-go-cli/internal/commands/shell"
+	_ "template-go-cli/internal/commands/version"
+)
@@ -0,0 +1,37 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the lint command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "lint",
+		Short: "Lint repository artifacts",
+		Long:  "Validates repository artifacts -- such as commit messages -- against the project's conventions.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Lint the commits since the most recent tag"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s lint commit --range \"$(git describe --tags --abbrev=0)..HEAD\"", constants.Name)),
+		}, "\n"),
+		Args:             cobra.NoArgs,
+		TraverseChildren: true,
+	}
+
+	command.AddCommand(commit(), hooks())
+
+	return command
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 110,
+	})
+}
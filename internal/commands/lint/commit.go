@@ -0,0 +1,143 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/conventional"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/git"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Finding represents a [conventional.Diagnostic] attributed to its source -- a message file or a commit hash.
+type Finding struct {
+	Source string `json:"source" yaml:"source"`
+	conventional.Diagnostic
+}
+
+// commit returns the lint commit sub-command.
+func commit() *cobra.Command {
+	var (
+		directory  string
+		revisions  string
+		suppressed bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "commit [file]",
+		Short: "Lint Conventional Commit messages",
+		Long:  "Validates a commit message file (or \"-\" for standard-input), or every commit within a revision range, against the Conventional Commits specification and the types documented by \".gitmessage\": " + strings.Join(conventional.Names(), ", ") + ". Diagnostics are printed as \"<source>:<line>:<column>: <severity>: <message> [<rule>]\", unless an --output format is explicitly specified. The command exits non-zero if any error is found.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Lint a commit message file (e.g. from a commit-msg hook)"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s lint commit .git/COMMIT_EDITMSG", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Lint every commit on the current branch that isn't on main"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s lint commit --range main..HEAD", constants.Name)),
+		}, "\n"),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			type message struct {
+				source  string
+				content string
+			}
+
+			var messages []message
+
+			switch {
+			case len(args) == 1 && revisions != "":
+				return exceptions.New(exceptions.Usage, "a message file and --range are mutually exclusive")
+			case len(args) == 1:
+				var content []byte
+				var e error
+				if args[0] == "-" {
+					content, e = io.ReadAll(cmd.InOrStdin())
+				} else {
+					content, e = system.Get(ctx).Filesystem.ReadFile(args[0])
+				}
+
+				if e != nil {
+					return exceptions.Wrap(exceptions.NotFound, e, "unable to read commit message")
+				}
+
+				messages = append(messages, message{source: args[0], content: string(content)})
+			case revisions != "":
+				repository, e := git.Open(ctx, directory)
+				if e != nil {
+					return exceptions.Wrap(exceptions.NotFound, e, "not a git repository")
+				}
+
+				entries, e := repository.Log(ctx, revisions)
+				if e != nil {
+					return exceptions.Wrap(exceptions.NotFound, e, "unable to read revisions %q", revisions)
+				}
+
+				for _, entry := range entries {
+					messages = append(messages, message{source: entry.Hash[:min(len(entry.Hash), 12)], content: entry.Message})
+				}
+			default:
+				return exceptions.New(exceptions.Usage, "a message file or --range is required").WithHint("see \"%s lint commit --help\"", constants.Name)
+			}
+
+			var findings = []Finding{}
+			var errors int
+
+			for _, m := range messages {
+				log.Log(ctx, level.Trace.Level(), "Linting commit message", slog.String("input", m.source))
+
+				for _, diagnostic := range conventional.Lint(conventional.Clean(m.content)) {
+					if diagnostic.Severity == conventional.Error {
+						errors++
+					} else if suppressed {
+						continue
+					}
+
+					findings = append(findings, Finding{Source: m.source, Diagnostic: diagnostic})
+				}
+			}
+
+			result.Record(ctx, findings)
+
+			if cmd.Flags().Changed("output") {
+				buffer, e := output.Write(format.Get(ctx), findings)
+				if e != nil {
+					return e
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+			} else {
+				for _, finding := range findings {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s:%s\n", finding.Source, finding.Diagnostic)
+				}
+			}
+
+			if errors > 0 {
+				return exceptions.New(exceptions.Validation, "%d error(s) found in %d commit message(s)", errors, len(messages)).WithHint("see the repository's \".gitmessage\" for the expected format")
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVarP(&directory, "directory", "C", ".", "a directory within the repository (for --range)")
+	flags.StringVar(&revisions, "range", "", "lint each commit within a revision range (e.g. \"v1.0.0..HEAD\")")
+	flags.BoolVar(&suppressed, "quiet", false, "suppress warnings")
+
+	return command
+}
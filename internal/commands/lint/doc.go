@@ -0,0 +1,2 @@
+// Package lint provides the lint cli sub-command(s).
+package lint
@@ -0,0 +1,133 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/git"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// marker identifies hooks written by the install-hooks sub-command, which may be safely overwritten.
+var marker = fmt.Sprintf("# Installed by \"%s lint install-hooks\".", constants.Name)
+
+// Hook represents an installed git hook.
+type Hook struct {
+	Name string `json:"name" yaml:"name"`
+	Path string `json:"path" yaml:"path"`
+}
+
+// quote quotes s as a single POSIX shell word: within single quotes, nothing -- "$", "`" nor "\" -- is expanded, and
+// each embedded single quote closes the quotes, is escaped with a backslash, and reopens them.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// hooks returns the lint install-hooks sub-command.
+func hooks() *cobra.Command {
+	var (
+		directory string
+		force     bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "install-hooks",
+		Short: "Install a commit-msg git hook that lints commit messages",
+		Long:  "Writes a \"commit-msg\" hook into the repository's hooks directory (honoring \"core.hooksPath\") that runs \"lint commit\" against each new commit message. An existing hook not written by this command is only replaced with --force.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Install the commit-msg hook into the current repository"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s lint install-hooks", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			repository, e := git.Open(ctx, directory)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "not a git repository")
+			}
+
+			path, e := repository.Hooks(ctx)
+			if e != nil {
+				return e
+			}
+
+			var datum = Hook{Name: "commit-msg", Path: filepath.Join(path, "commit-msg")}
+
+			var filesystem = system.Get(ctx).Filesystem
+
+			existing, e := filesystem.ReadFile(datum.Path)
+			if e != nil && !errors.Is(e, fs.ErrNotExist) {
+				return e
+			} else if e == nil && !force && !strings.Contains(string(existing), marker) {
+				return exceptions.New(exceptions.Conflict, "a %s hook already exists", datum.Name).WithHint("use --force to replace it").WithDetail("path", datum.Path)
+			}
+
+			log.Log(ctx, level.Trace.Level(), "Installing git hook", slog.String("path", datum.Path))
+
+			// Prefer the executable's name when it's resolvable via PATH, so the hook survives upgrades and reinstalls.
+			executable := constants.Name
+			if _, e := exec.LookPath(constants.Name); e != nil {
+				if executable, e = os.Executable(); e != nil {
+					return e
+				}
+			}
+
+			script := strings.Join([]string{
+				"#!/bin/sh",
+				marker,
+				"",
+				fmt.Sprintf("exec %s lint commit --quiet \"$1\"", quote(executable)),
+				"",
+			}, "\n")
+
+			if e := filesystem.MkdirAll(path, 0o755); e != nil {
+				return e
+			}
+
+			if e := filesystem.WriteFile(datum.Path, []byte(script), 0o755); e != nil {
+				return e
+			}
+
+			// The file mode is only applied on creation; ensure a replaced hook is also executable.
+			if e := filesystem.Chmod(datum.Path, 0o755); e != nil {
+				return e
+			}
+
+			result.Record(ctx, datum)
+
+			buffer, e := output.Write(format.Get(ctx), datum)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVarP(&directory, "directory", "C", ".", "a directory within the repository")
+	flags.BoolVar(&force, "force", false, "replace an existing hook")
+
+	return command
+}
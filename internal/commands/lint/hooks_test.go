@@ -0,0 +1,43 @@
+package lint
+
+import (
+	"os/exec"
+	"runtime"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "/usr/local/bin/tool", expected: `'/usr/local/bin/tool'`},
+		{value: "/Users/Jane Doe/bin/tool", expected: `'/Users/Jane Doe/bin/tool'`},
+		{value: "/home/o'brien/tool", expected: `'/home/o'\''brien/tool'`},
+		{value: "", expected: `''`},
+	}
+
+	for _, test := range tests {
+		if quoted := quote(test.value); quoted != test.expected {
+			t.Errorf("expected %q to be quoted as %s, received %s", test.value, test.expected, quoted)
+		}
+	}
+}
+
+func TestQuoteShell(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	// Characters a double-quoted (or Go-quoted) string would have the shell expand or misinterpret.
+	for _, value := range []string{"/tmp/$HOME/tool", "/tmp/`id`/tool", `/tmp/back\slash/"quoted"/tool`, "/tmp/it's/tool", "/tmp/'/''/tool", "/tmp/new\nline"} {
+		output, e := exec.Command("/bin/sh", "-c", "printf '%s' "+quote(value)).Output()
+		if e != nil {
+			t.Fatalf("unable to evaluate %s: %v", quote(value), e)
+		}
+
+		if string(output) != value {
+			t.Errorf("expected the shell to evaluate %s as %q, received %q", quote(value), value, output)
+		}
+	}
+}
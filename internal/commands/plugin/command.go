@@ -0,0 +1,39 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the plugin command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "plugin",
+		Short: "Inspect external plugin commands",
+		Long: strings.Join([]string{
+			fmt.Sprintf("Any executable named \"%s-<name>\" that's found in the plugin directory or on PATH becomes a sub-command named \"<name>\".", constants.Name),
+			fmt.Sprintf("Plugins receive all trailing arguments, and the resolved global flags are exported as \"%s_*\" environment variables.", constants.Prefix),
+		}, " "),
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# List all discovered plugins"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s plugin list", constants.Name)),
+		}, "\n"),
+		TraverseChildren: true,
+	}
+
+	command.AddCommand(list())
+
+	return command
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 100,
+	})
+}
@@ -0,0 +1,2 @@
+// Package plugin provides the plugin management cli sub-command(s).
+package plugin
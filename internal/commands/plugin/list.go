@@ -0,0 +1,53 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/plugins"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// list returns the plugin list sub-command.
+func list() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List discovered plugins, including shadowed executables and conflicts",
+		Long:  "Lists every discovered plugin. Plugins conflicting with a built-in command are never attached, and executables overshadowed by an earlier search-path entry are reported as shadowed.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# List plugins as yaml"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s plugin list --output yaml", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Listing plugins", "directories", plugins.Directories(ctx))
+
+			var datum = plugins.Discover(ctx, plugins.Builtins(cmd.Root()))
+
+			result.Record(ctx, datum)
+
+			buffer, e := output.Write(format.Get(ctx), datum)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+		SilenceErrors: true,
+	}
+
+	return command
+}
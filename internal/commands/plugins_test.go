@@ -0,0 +1,61 @@
+package commands_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/harness"
+)
+
+func TestPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	// The plugin echoes its name, the inherited output flag and its arguments, and fails upon a "fail" argument.
+	script := "#!/bin/sh\n" +
+		"echo \"$" + constants.Prefix + "_PLUGIN_NAME $" + constants.Prefix + "_OUTPUT $*\"\n" +
+		"[ \"$1\" = fail ] && exit 7\n" +
+		"exit 0\n"
+
+	directory := t.TempDir()
+	if e := os.WriteFile(filepath.Join(directory, constants.Name+"-hello"), []byte(script), 0o755); e != nil {
+		t.Fatal(e)
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{name: "dispatch", args: []string{"hello", "--flag", "argument"}, stdout: "hello json --flag argument\n"},
+		{name: "flags", args: []string{"--output", "yaml", "hello", "argument"}, stdout: "hello yaml argument\n"},
+		{name: "exit-code", args: []string{"hello", "fail"}, code: 7, stdout: "hello json fail\n", stderr: `plugin "hello" failed`},
+		{name: "help", args: []string{"--help"}, stdout: "Plugin Commands\n  hello"},
+		{name: "unknown", args: []string{"missing"}, code: 2, stderr: `unknown command "missing"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := harness.Run(t, harness.Options{Args: test.args, Env: map[string]string{"PATH": directory}})
+
+			if result.Code != test.code {
+				t.Fatalf("expected exit code %d, received %d; standard-error:\n%s", test.code, result.Code, result.Stderr)
+			}
+
+			if !strings.Contains(result.Stdout, test.stdout) {
+				t.Fatalf("expected standard-output to contain %q; received:\n%s", test.stdout, result.Stdout)
+			}
+
+			if !strings.Contains(result.Stderr, test.stderr) {
+				t.Fatalf("expected standard-error to contain %q; received:\n%s", test.stderr, result.Stderr)
+			}
+		})
+	}
+}
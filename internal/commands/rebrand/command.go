@@ -0,0 +1,102 @@
+package rebrand
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/rebrand"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the init command.
+func New() *cobra.Command {
+	var (
+		options   rebrand.Options
+		directory string
+		dry       bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "init",
+		Short: "Re-brand a checkout of the template into a new cli",
+		Long:  "Rewrites the go module path, the executable's name and environment variable prefix -- wherever go sources, test scripts and golden files reference them -- goreleaser's project and cask names, the Makefile's name and the README's placeholders across a checkout of the template. Use \"--dry-run\" to review a unified diff without modifying any files.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Preview the changes"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s init --module github.com/example/tool --name tool --owner example --dry-run", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Re-brand the checkout in the current directory"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s init --module github.com/example/tool --name tool --owner example", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Re-branding checkout", "directory", directory, "module", options.Module, "name", options.Name, "owner", options.Owner)
+
+			changes, e := rebrand.Plan(system.Get(ctx).Filesystem, directory, options)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "unable to plan re-brand")
+			}
+
+			result.Record(ctx, changes)
+
+			if dry {
+				for _, change := range changes {
+					fmt.Fprint(cmd.OutOrStdout(), change.Diff())
+				}
+
+				return nil
+			}
+
+			if e := rebrand.Apply(system.Get(ctx).Filesystem, directory, changes); e != nil {
+				return e
+			}
+
+			log.InfoContext(ctx, "Re-branded checkout; run \"go mod tidy && go mod vendor\" to verify", "files", len(changes))
+
+			buffer, e := output.Write(format.Get(ctx), changes)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVar(&options.Module, "module", "", "the new go module path (e.g. \"github.com/example/tool\")")
+	flags.StringVar(&options.Name, "name", "", "the new executable and project name (e.g. \"tool\")")
+	flags.StringVar(&options.Owner, "owner", "", "the GitHub owner hosting the project and homebrew tap (defaults to the current owner)")
+	flags.StringVarP(&directory, "directory", "C", ".", "the checkout's root directory")
+	flags.BoolVar(&dry, "dry-run", false, "print a unified diff rather than modifying files")
+
+	for _, name := range []string{"module", "name"} {
+		if e := command.MarkFlagRequired(name); e != nil {
+			panic(e)
+		}
+	}
+
+	return command
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 100,
+	})
+}
@@ -0,0 +1,2 @@
+// Package rebrand provides the "init" cli sub-command, which re-brands a checkout of the template into a new cli.
+package rebrand
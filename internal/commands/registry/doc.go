@@ -0,0 +1,6 @@
+// Package registry provides the in-process command registry. Command packages self-register their commands'
+// constructors from their init functions via [Register], and [Build] assembles a new cobra command tree --
+// including any [cobra.Group] -- from the registrations.
+//
+// Registering a command package only requires a blank import (see "internal/commands/imports.go").
+package registry
@@ -0,0 +1,178 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"template-go-cli/internal/config"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// Registration describes a command and the metadata used to place it in the command tree.
+type Registration struct {
+	// New constructs the command, including any sub-commands and flags. It's called once per [Build], so that
+	// each command tree -- and its flags' state -- is independent of any other.
+	New func() *cobra.Command
+
+	// Parent is the space-separated path of the parent command relative to the root (e.g. "plugin"). An empty
+	// value attaches the command to the root.
+	Parent string
+
+	// Group optionally places the command in a help-group on its parent. Groups are created on first use; the
+	// first registration's title wins for a given group identifier.
+	Group *cobra.Group
+
+	// Order sorts registrations (ascending) before they're added; groups are created in the same order. Ties
+	// are broken by command name.
+	Order int
+
+	// Feature optionally gates the command behind a named feature flag; see [Enabled].
+	Feature string
+
+	Hidden       bool // Hidden omits the command from help and completion output.
+	Experimental bool // Experimental only registers the command when experimental commands are enabled; see [Experimental].
+}
+
+// Annotation is the [cobra.Command.Annotations] key that marks a command as experimental; see
+// [Registration.Experimental].
+const Annotation = "experimental"
+
+var (
+	mutex         sync.Mutex
+	registrations []Registration
+)
+
+// Register adds a command registration. It's intended to be called from a command package's init function, and
+// panics when r has no constructor.
+func Register(r Registration) {
+	if r.New == nil {
+		panic("registry: registration is missing a constructor")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	registrations = append(registrations, r)
+}
+
+// entry is an enabled registration along with its newly constructed command.
+type entry struct {
+	Registration
+
+	command *cobra.Command
+}
+
+// Build constructs every enabled registration's command -- per the context's environment and configuration -- and
+// adds it to the root command's tree. Commands are added in order of their parent's depth -- parents always precede
+// their children -- then [Registration.Order], then name. An error is returned if a parent cannot be resolved.
+func Build(ctx context.Context, root *cobra.Command) error {
+	mutex.Lock()
+	var snapshot = slices.Clone(registrations)
+	mutex.Unlock()
+
+	var entries = make([]entry, 0, len(snapshot))
+	for _, r := range snapshot {
+		if r.Experimental && !Experimental(ctx) {
+			continue
+		}
+
+		if r.Feature != "" && !Enabled(ctx, r.Feature) {
+			continue
+		}
+
+		entries = append(entries, entry{Registration: r, command: r.New()})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := depth(entries[i].Parent), depth(entries[j].Parent)
+		if a != b {
+			return a < b
+		}
+
+		if entries[i].Order != entries[j].Order {
+			return entries[i].Order < entries[j].Order
+		}
+
+		return entries[i].command.Name() < entries[j].command.Name()
+	})
+
+	for _, r := range entries {
+		parent := root
+		if r.Parent != "" {
+			command, remaining, e := root.Find(strings.Fields(r.Parent))
+			if e != nil || len(remaining) > 0 || command == root {
+				return fmt.Errorf("registry: unable to resolve parent %q of command %q", r.Parent, r.command.Name())
+			}
+
+			parent = command
+		}
+
+		if r.Group != nil {
+			if !parent.ContainsGroup(r.Group.ID) {
+				parent.AddGroup(&cobra.Group{ID: r.Group.ID, Title: r.Group.Title})
+			}
+
+			r.command.GroupID = r.Group.ID
+		}
+
+		if r.Hidden {
+			r.command.Hidden = true
+		}
+
+		if r.Experimental {
+			if r.command.Annotations == nil {
+				r.command.Annotations = map[string]string{}
+			}
+
+			r.command.Annotations[Annotation] = "true"
+		}
+
+		parent.AddCommand(r.command)
+	}
+
+	return nil
+}
+
+// Enabled reports whether the named feature is enabled via the comma-separated "<PREFIX>_FEATURES" environment
+// variable or the configuration file's "features" list. Matching is case-insensitive; the special value "all"
+// enables every feature.
+func Enabled(ctx context.Context, feature string) bool {
+	var features = strings.Split(system.Get(ctx).Getenv(constants.Prefix+"_FEATURES"), ",")
+	if configuration, e := config.Current(ctx); e == nil {
+		features = append(features, configuration.Features...)
+	}
+
+	for _, v := range features {
+		v = strings.TrimSpace(v)
+		if strings.EqualFold(v, feature) || strings.EqualFold(v, "all") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Experimental reports whether experimental commands are enabled via the boolean "<PREFIX>_EXPERIMENTAL"
+// environment variable, which takes precedence over the configuration file's "experimental" setting.
+func Experimental(ctx context.Context) bool {
+	if enabled, e := strconv.ParseBool(system.Get(ctx).Getenv(constants.Prefix + "_EXPERIMENTAL")); e == nil {
+		return enabled
+	}
+
+	configuration, e := config.Current(ctx)
+
+	return e == nil && configuration.Experimental
+}
+
+// depth returns the number of path segments in a space-separated parent path.
+func depth(parent string) int {
+	return len(strings.Fields(parent))
+}
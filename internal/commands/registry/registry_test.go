@@ -0,0 +1,210 @@
+package registry
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// isolate replaces the package's registrations with the given ones for the duration of the test.
+func isolate(t *testing.T, r ...Registration) {
+	mutex.Lock()
+	var original = registrations
+	registrations = nil
+	mutex.Unlock()
+
+	t.Cleanup(func() {
+		mutex.Lock()
+		registrations = original
+		mutex.Unlock()
+	})
+
+	for _, registration := range r {
+		Register(registration)
+	}
+}
+
+// environment returns a context whose system has an isolated home directory and the given variables.
+func environment(t *testing.T, variables map[string]string) context.Context {
+	home := t.TempDir()
+
+	environment := map[string]string{"HOME": home, "XDG_CONFIG_HOME": filepath.Join(home, ".config"), constants.Prefix + "_CONFIG": "", constants.Prefix + "_FEATURES": "", constants.Prefix + "_EXPERIMENTAL": ""}
+	for key, value := range variables {
+		environment[key] = value
+	}
+
+	return system.With(context.Background(), system.System{Env: system.Environment(environment)})
+}
+
+// command returns a constructor of a runnable command with the given name.
+func command(name string) func() *cobra.Command {
+	return func() *cobra.Command {
+		return &cobra.Command{Use: name, Run: func(*cobra.Command, []string) {}}
+	}
+}
+
+// names returns the names of the command's sub-commands, in the order they were added.
+func names(cmd *cobra.Command) []string {
+	var collection []string
+	for _, child := range cmd.Commands() {
+		collection = append(collection, child.Name())
+	}
+
+	return collection
+}
+
+// build builds a new root command from the registrations, failing the test upon error.
+func build(t *testing.T, ctx context.Context) *cobra.Command {
+	t.Helper()
+
+	root := &cobra.Command{Use: constants.Name}
+
+	// Retain the order in which commands are added, rather than sorting them by name.
+	cobra.EnableCommandSorting = false
+	t.Cleanup(func() { cobra.EnableCommandSorting = true })
+
+	if e := Build(ctx, root); e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	return root
+}
+
+func TestBuildOrdering(t *testing.T) {
+	// Children are registered ahead of their parents, and out of order.
+	isolate(t,
+		Registration{New: command("nested"), Parent: "group child"},
+		Registration{New: command("child"), Parent: "group"},
+		Registration{New: command("zulu"), Order: 1},
+		Registration{New: command("group")},
+		Registration{New: command("alpha"), Order: 1},
+		Registration{New: command("first"), Order: -1},
+	)
+
+	root := build(t, environment(t, nil))
+
+	if expected := []string{"first", "group", "alpha", "zulu"}; !slices.Equal(names(root), expected) {
+		t.Fatalf("expected the root's commands %q, received %q", expected, names(root))
+	}
+
+	nested, _, e := root.Find([]string{"group", "child", "nested"})
+	if e != nil || nested.CommandPath() != constants.Name+" group child nested" {
+		t.Fatalf("expected the nested command to be attached to its parent, received %v (%v)", nested.CommandPath(), e)
+	}
+}
+
+func TestBuildGrouping(t *testing.T) {
+	isolate(t,
+		Registration{New: command("b"), Group: &cobra.Group{ID: "tools", Title: "Tools"}, Order: 2},
+		Registration{New: command("a"), Group: &cobra.Group{ID: "tools", Title: "Ignored"}, Order: 1},
+		Registration{New: command("c"), Group: &cobra.Group{ID: "other", Title: "Other"}, Order: 3},
+		Registration{New: command("d")},
+	)
+
+	root := build(t, environment(t, nil))
+
+	groups := root.Groups()
+	if len(groups) != 2 || groups[0].ID != "tools" || groups[0].Title != "Ignored" || groups[1].ID != "other" {
+		t.Fatalf("expected the groups to be created in order, with the first registration's title, received %+v %+v", groups[0], groups[1:])
+	}
+
+	for name, group := range map[string]string{"a": "tools", "b": "tools", "c": "other", "d": ""} {
+		cmd, _, e := root.Find([]string{name})
+		if e != nil || cmd.GroupID != group {
+			t.Errorf("expected %q to be in group %q, received %q (%v)", name, group, cmd.GroupID, e)
+		}
+	}
+}
+
+func TestBuildIdempotence(t *testing.T) {
+	isolate(t,
+		Registration{New: command("child"), Parent: "group", Group: &cobra.Group{ID: "children", Title: "Children"}},
+		Registration{New: command("group"), Group: &cobra.Group{ID: "groups", Title: "Groups"}},
+		Registration{New: command("hidden"), Hidden: true},
+	)
+
+	ctx := environment(t, nil)
+
+	first, second := build(t, ctx), build(t, ctx)
+
+	// Rebuilding yields an identical, though independent, tree.
+	if !slices.Equal(names(first), names(second)) || len(first.Groups()) != len(second.Groups()) {
+		t.Fatalf("expected identical trees, received %q and %q", names(first), names(second))
+	}
+
+	for i, command := range first.Commands() {
+		if command == second.Commands()[i] {
+			t.Fatalf("expected %q to be constructed for each build", command.Name())
+		}
+
+		if len(command.Commands()) != len(second.Commands()[i].Commands()) || command.Hidden != second.Commands()[i].Hidden {
+			t.Fatalf("expected %q to be built identically", command.Name())
+		}
+	}
+
+	group, _, _ := first.Find([]string{"group"})
+	if len(group.Commands()) != 1 || len(group.Groups()) != 1 {
+		t.Fatalf("expected the group to be built once, received %q", names(group))
+	}
+}
+
+func TestBuildGating(t *testing.T) {
+	isolate(t,
+		Registration{New: command("stable")},
+		Registration{New: command("featured"), Feature: "preview"},
+		Registration{New: command("experimental"), Experimental: true},
+	)
+
+	tests := []struct {
+		name      string
+		variables map[string]string
+		expected  []string
+	}{
+		{name: "default", expected: []string{"stable"}},
+		{name: "feature", variables: map[string]string{constants.Prefix + "_FEATURES": "other, PREVIEW"}, expected: []string{"featured", "stable"}},
+		{name: "all", variables: map[string]string{constants.Prefix + "_FEATURES": "all"}, expected: []string{"featured", "stable"}},
+		{name: "experimental", variables: map[string]string{constants.Prefix + "_EXPERIMENTAL": "true"}, expected: []string{"experimental", "stable"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := build(t, environment(t, test.variables))
+
+			if !slices.Equal(names(root), test.expected) {
+				t.Fatalf("expected the commands %q, received %q", test.expected, names(root))
+			}
+
+			if cmd, _, e := root.Find([]string{"experimental"}); e == nil && cmd != root && cmd.Annotations["experimental"] != "true" {
+				t.Fatal("expected the experimental command to be annotated")
+			}
+		})
+	}
+}
+
+func TestBuildUnresolvedParent(t *testing.T) {
+	isolate(t, Registration{New: command("orphan"), Parent: "missing"})
+
+	e := Build(environment(t, nil), &cobra.Command{Use: constants.Name})
+	if e == nil || !strings.Contains(e.Error(), `unable to resolve parent "missing" of command "orphan"`) {
+		t.Fatalf("expected an unresolved parent error, received %v", e)
+	}
+}
+
+func TestRegisterWithoutConstructor(t *testing.T) {
+	isolate(t)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a registration without a constructor to panic")
+		}
+	}()
+
+	Register(Registration{})
+}
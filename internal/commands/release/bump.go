@@ -0,0 +1,182 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/git"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/semver"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Result represents the bump sub-command's output.
+type Result struct {
+	Previous string `json:"previous" yaml:"previous"`
+	Next     string `json:"next" yaml:"next"`
+	Tag      string `json:"tag" yaml:"tag"`
+	Message  string `json:"message" yaml:"message"`
+	Clean    bool   `json:"clean" yaml:"clean"`
+	DryRun   bool   `json:"dry-run" yaml:"dry-run"`
+}
+
+// bump returns the release bump sub-command.
+func bump() *cobra.Command {
+	var (
+		directory string
+		metadata  string
+		dry       bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "bump <major|minor|patch|pre> [identifier]",
+		Short: "Increment the semantic version, then commit and tag the release",
+		Long:  "Increments the semantic version in the repository's \"VERSION\" file -- including pre-release versions (e.g. \"pre rc\") and optional build metadata -- then commits the file and creates an annotated \"v<version>\" tag, locally. The working tree must be clean. Nothing is pushed.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Release the next patch version"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s release bump patch", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Cut (or increment) a release candidate"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s release bump pre rc", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Preview a major version bump"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s release bump major --dry-run", constants.Name)),
+		}, "\n"),
+		Args: cobra.RangeArgs(1, 2),
+		ValidArgs: []cobra.Completion{
+			string(semver.Major), string(semver.Minor), string(semver.Patch), string(semver.Prerelease),
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			increment := semver.Increment(args[0])
+			if !slices.Contains([]semver.Increment{semver.Major, semver.Minor, semver.Patch, semver.Prerelease}, increment) {
+				return exceptions.New(exceptions.Usage, "invalid increment %q", args[0]).WithHint("specify one of: major, minor, patch, pre")
+			}
+
+			var id string
+			if len(args) == 2 {
+				if increment != semver.Prerelease {
+					return exceptions.New(exceptions.Usage, "an identifier is only applicable to pre-release increments")
+				}
+
+				id = args[1]
+			}
+
+			repository, e := git.Open(ctx, directory)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "not a git repository")
+			}
+
+			root, e := repository.Root(ctx)
+			if e != nil {
+				return e
+			}
+
+			var filesystem = system.Get(ctx).Filesystem
+
+			file := filepath.Join(root, "VERSION")
+
+			content, e := filesystem.ReadFile(file)
+			if errors.Is(e, fs.ErrNotExist) {
+				content = []byte("0.0.0")
+			} else if e != nil {
+				return e
+			}
+
+			previous, e := semver.Parse(string(content))
+			if e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "invalid VERSION file")
+			}
+
+			next, e := previous.Bump(increment, id)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "")
+			}
+
+			if metadata != "" {
+				next, e = semver.Parse(next.String() + "+" + metadata)
+				if e != nil {
+					return exceptions.Wrap(exceptions.Validation, e, "invalid build metadata %q", metadata)
+				}
+			}
+
+			clean, dirty, e := repository.Clean(ctx)
+			if e != nil {
+				return e
+			}
+
+			var datum = Result{
+				Previous: previous.String(),
+				Next:     next.String(),
+				Tag:      "v" + next.String(),
+				Message:  fmt.Sprintf("chore(release): tag release - v%s", next),
+				Clean:    clean,
+				DryRun:   dry,
+			}
+
+			log.Log(ctx, level.Trace.Level(), "Bumping version", slog.Any("result", datum))
+
+			if !dry {
+				if !clean {
+					return exceptions.New(exceptions.Conflict, "dirty working tree").WithHint("commit or stash changes and try again").WithDetail("paths", dirty)
+				}
+
+				tags, e := repository.Tags(ctx)
+				if e != nil {
+					return e
+				}
+
+				if slices.Contains(tags, datum.Tag) {
+					return exceptions.New(exceptions.Conflict, "tag %q already exists", datum.Tag)
+				}
+
+				if e := filesystem.WriteFile(file, []byte(datum.Next+"\n"), 0o644); e != nil {
+					return e
+				}
+
+				if e := repository.Commit(ctx, datum.Message, file); e != nil {
+					return e
+				}
+
+				if e := repository.Tag(ctx, datum.Tag, datum.Message); e != nil {
+					return e
+				}
+			}
+
+			result.Record(ctx, datum)
+
+			buffer, e := output.Write(format.Get(ctx), datum)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVarP(&directory, "directory", "C", ".", "a directory within the repository")
+	flags.StringVar(&metadata, "build", "", "optional build metadata to append (e.g. \"exp.sha.5114f85\")")
+	flags.BoolVar(&dry, "dry-run", false, "report the next version without modifying the repository")
+
+	return command
+}
@@ -0,0 +1,37 @@
+package release
+
+import (
+	"fmt"
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+
+	"github.com/spf13/cobra"
+)
+
+// New returns the release command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "release",
+		Short: "Manage releases of a repository",
+		Long:  "Release management for a git repository that tracks its semantic version in a \"VERSION\" file.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Preview a minor version bump"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s release bump minor --dry-run", constants.Name)),
+		}, "\n"),
+		Args:             cobra.NoArgs,
+		TraverseChildren: true,
+	}
+
+	command.AddCommand(bump())
+
+	return command
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 100,
+	})
+}
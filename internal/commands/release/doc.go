@@ -0,0 +1,2 @@
+// Package release provides the release management cli sub-command(s).
+package release
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Root returns a new root command, with its persistent flags and logging bootstrap. Each call returns an
+// independent root (and flag state); see [New] for adding the registered child commands.
+func Root() *cobra.Command {
+	// lvl represents the log-level flag set by a persisted global flag.
+	var lvl level.Type = "info"
+
+	// src represents the cli flag to include source logging.
+	var src bool = true
+
+	// the output format if applicable for downstream commands.
+	var out output.Type = "json"
+
+	// The PersistentPreRun and PreRun functions will be executed before Run. PersistentPostRun and PostRun will be executed
+	// after Run. The Persistent*Run functions will be inherited by children if they do not declare their own. The *PreRun
+	// and *PostRun functions will only be executed if the Run function of the current command has been declared. These
+	// functions are run in the following order:
+	//
+	// - PersistentPreRun
+	// - PreRun
+	// - Run
+	// - PostRun
+	// - PersistentPostRun
+	//
+	// https://github.com/spf13/cobra/blob/main/site/content/user_guide.md
+	//
+
+	var root = &cobra.Command{
+		Use:                        fmt.Sprintf("%s", constants.Name),
+		Short:                      "A cli tool [...]",
+		Long:                       strings.Join([]string{"A cli tool [...]", level.Enumeration.Describe("log-level"), output.Enumeration.Describe("output")}, "\n\n"),
+		Example:                    "",
+		Annotations:                map[string]string{},
+		Version:                    build.Version,
+		SuggestionsMinimumDistance: 3,
+
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			// Setup slog-specific logging.
+			writer := cmd.ErrOrStderr()
+			addsource := src && build.Sources == "include"
+			options := &slog.HandlerOptions{AddSource: addsource, Level: lvl.Level(), ReplaceAttr: logging.Replacements}
+			var handler slog.Handler = slog.NewTextHandler(writer, options)
+
+			// Capture trace-level log records in memory, regardless of log-level, for failure and crash reports.
+			if ring := logging.GetRing(ctx); ring != nil {
+				handler = logging.Tee(handler, ring.Handler(options))
+			}
+
+			logger := slog.New(handler)
+
+			log := logger.With(slog.String("command", cmd.Name()))
+
+			// Propagate updated logger into context; the process' default logger is left untouched, as the command
+			// may be executed in-process alongside others (e.g. via the "pkg/api" package).
+			ctx = logging.With(ctx, log)
+			cmd.SetContext(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Starting Application", slog.String("version", build.Version), slog.String("commit", build.Commit), slog.String("date", build.Date))
+
+			// Propagate persistent flags into context for easy retrieval and strict typing.
+
+			ctx = format.With(ctx, out)
+			cmd.SetContext(ctx)
+
+			return nil
+		},
+		CompletionOptions: cobra.CompletionOptions{
+			DisableDefaultCmd:   false,
+			DisableNoDescFlag:   false,
+			DisableDescriptions: false,
+			HiddenDefaultCmd:    false,
+		},
+		TraverseChildren: true,
+	}
+
+	root.PersistentFlags().VarP(&lvl, "log-level", "z", "log-level verbosity")
+	root.PersistentFlags().BoolVarP(&src, "include-source-locations", "x", true, "include log locations")
+	root.PersistentFlags().VarP(&out, "output", "o", "command output format; not applicable to all commands")
+
+	root.RegisterFlagCompletionFunc("log-level", level.Completion)
+	root.RegisterFlagCompletionFunc("output", output.Completion)
+
+	return root
+}
@@ -0,0 +1,202 @@
+package selfupdate
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/semver"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+	"template-go-cli/internal/update"
+
+	"github.com/spf13/cobra"
+)
+
+// Result represents the self-update command's output.
+type Result struct {
+	Current string `json:"current" yaml:"current"`
+	Latest  string `json:"latest" yaml:"latest"`
+	Asset   string `json:"asset,omitempty" yaml:"asset,omitempty"`
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+	Updated bool   `json:"updated" yaml:"updated"`
+}
+
+// New returns the self-update command.
+func New() *cobra.Command {
+	var (
+		feed  string
+		key   string
+		check bool
+		force bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "self-update",
+		Short: "Update the cli to the latest release",
+		Long:  fmt.Sprintf("Reads the release manifest from a feed -- a local directory or an HTTP(S) location -- and, if a newer version is available, downloads the archive for the running platform, verifies the release's ed25519 signature and the archive's SHA-256 checksum, then atomically replaces the running executable. The previous executable is restored should the replacement fail verification. The feed defaults to the \"%[1]s_UPDATE_FEED\" environment variable, then the configuration file's \"update.feed\"; the public key defaults to the \"%[1]s_UPDATE_KEY\" environment variable, then the key embedded at build time.", constants.Prefix),
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Check for a newer release without installing it"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s self-update --check --feed https://example.com/releases/latest", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Update from a local release directory"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s self-update --feed ./dist", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			if feed == "" {
+				feed = update.Location(ctx)
+			}
+
+			if key == "" {
+				key = fallback(system.Get(ctx).Getenv(constants.Prefix+"_UPDATE_KEY"), build.Key)
+			}
+
+			if feed == "" {
+				return exceptions.New(exceptions.Usage, "a release feed is required").WithHint("specify --feed, set %s_UPDATE_FEED, or configure \"update.feed\"", constants.Prefix)
+			}
+
+			current, e := semver.Parse(build.Version)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Internal, e, "invalid executable version %q", build.Version)
+			}
+
+			source := &update.Feed{Location: feed}
+
+			log.Log(ctx, level.Trace.Level(), "Reading release manifest", slog.String("feed", feed))
+
+			manifest, e := source.Manifest(ctx)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "unable to read release manifest")
+			}
+
+			latest, e := semver.Parse(strings.TrimPrefix(manifest.Version, "v"))
+			if e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "invalid release manifest version %q", manifest.Version)
+			}
+
+			var datum = Result{Current: current.String(), Latest: latest.String()}
+
+			if check || (latest.Compare(current) <= 0 && !force) {
+				log.InfoContext(ctx, "Update check complete", slog.Bool("available", latest.Compare(current) > 0))
+
+				return write(cmd, datum)
+			}
+
+			if key == "" {
+				return exceptions.New(exceptions.Validation, "no release signing key is configured").WithHint("specify --public-key, or set %s_UPDATE_KEY", constants.Prefix)
+			}
+
+			public, e := update.PublicKey(key)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Usage, e, "")
+			}
+
+			asset, e := manifest.Select()
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "").WithDetail("assets", manifest.Assets)
+			}
+
+			datum.Asset = asset
+
+			checksums, e := source.Read(ctx, manifest.Checksums)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "unable to read checksum file")
+			}
+
+			signature, e := source.Read(ctx, manifest.Signature)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "unable to read signature file")
+			}
+
+			if e := update.Verify(public, manifest.Version, checksums, signature); e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "").WithHint("the release feed may be compromised; the executable wasn't modified")
+			}
+
+			log.Log(ctx, level.Trace.Level(), "Downloading release asset", slog.String("asset", asset))
+
+			archive, e := source.Read(ctx, asset)
+			if e != nil {
+				return exceptions.Wrap(exceptions.NotFound, e, "unable to read release asset")
+			}
+
+			if e := update.Checksum(checksums, asset, archive); e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "").WithHint("the release feed may be compromised; the executable wasn't modified")
+			}
+
+			executable, e := update.Extract(asset, archive)
+			if e != nil {
+				return exceptions.Wrap(exceptions.Validation, e, "invalid release asset")
+			}
+
+			datum.Path, e = os.Executable()
+			if e != nil {
+				return e
+			}
+
+			if e := update.Replace(ctx, datum.Path, executable, latest.String()); e != nil {
+				return exceptions.Wrap(exceptions.Internal, e, "unable to replace executable").WithDetail("path", datum.Path)
+			}
+
+			datum.Updated = true
+
+			log.InfoContext(ctx, "Updated executable", slog.String("previous", datum.Current), slog.String("version", datum.Latest))
+
+			return write(cmd, datum)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVar(&feed, "feed", "", "the release feed: a local directory or an HTTP(S) location (defaults to the configured feed)")
+	flags.StringVar(&key, "public-key", "", "the base64-encoded ed25519 public key verifying release signatures (defaults to the configured key)")
+	flags.BoolVar(&check, "check", false, "only report whether a newer release is available")
+	flags.BoolVar(&force, "force", false, "install the feed's release even if it isn't newer")
+
+	return command
+}
+
+// write renders the command's output.
+func write(cmd *cobra.Command, datum Result) error {
+	result.Record(cmd.Context(), datum)
+
+	buffer, e := output.Write(format.Get(cmd.Context()), datum)
+	if e != nil {
+		return e
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+	return nil
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 120,
+	})
+}
+
+// fallback returns the first non-empty value.
+func fallback(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
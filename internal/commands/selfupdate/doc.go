@@ -0,0 +1,2 @@
+// Package selfupdate provides the self-update cli sub-command.
+package selfupdate
@@ -0,0 +1,237 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/paths"
+	"template-go-cli/internal/readline"
+	"template-go-cli/internal/session"
+	"template-go-cli/internal/types/level"
+
+	"github.com/spf13/cobra"
+)
+
+// Limit is the maximum number of retained history entries.
+const Limit = 1000
+
+// New returns the shell command.
+func New() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "shell",
+		Short: "Start an interactive shell",
+		Long: strings.Join([]string{
+			"Opens an interactive session over the command tree, paying the cli's start-up cost once. Each line is a command-line, without the executable's name.",
+			"",
+			"Lines are edited in place, with history -- persisted to the state directory -- and tab completion. Ctrl-C cancels the running command, or abandons the current line; Ctrl-D or \"exit\" ends the session. Lines starting with \"#\" are comments.",
+			"",
+			"Built-ins:",
+			"  set [<flag> <value>]  persist a global flag (e.g. \"set output yaml\") across commands, or list the persisted flags",
+			"  unset <flag>          remove a persisted global flag",
+			"  history               list the command history",
+			"  exit                  end the session",
+		}, "\n"),
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Start an interactive session"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s shell", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Run a sequence of commands"),
+			fmt.Sprintf("  %s", fmt.Sprintf("printf 'set output yaml\\nversion\\n' | %s shell", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			controls, ok := session.Get(ctx)
+			if !ok || controls.Run == nil {
+				return exceptions.New(exceptions.Internal, "the shell must be executed via the root command")
+			}
+
+			root := cmd.Root()
+
+			var history = &readline.History{Limit: Limit}
+			if directory, e := paths.State(ctx); e != nil {
+				log.WarnContext(ctx, "Unable to resolve the state directory; history won't be persisted", slog.String("error", e.Error()))
+			} else if history, e = readline.Load(filepath.Join(directory, "history"), Limit); e != nil {
+				log.WarnContext(ctx, "Unable to load history", slog.String("error", e.Error()))
+			}
+
+			log.Log(ctx, level.Trace.Level(), "Starting interactive shell", slog.String("history", history.Path))
+
+			editor := &readline.Editor{
+				Input:    cmd.InOrStdin(),
+				Output:   cmd.ErrOrStderr(),
+				Prompt:   constants.Name + "> ",
+				History:  history,
+				Complete: completer(ctx, root, controls),
+			}
+
+			// Each command handles -- and is cancelled by -- SIGINT itself; see [session.Controls.Run]. Terminating
+			// signals end the session: immediately at the prompt, or once the running command has been cancelled.
+			controls.Detach()
+
+			var running, terminated atomic.Bool
+
+			signals := make(chan os.Signal, 1)
+
+			signal.Notify(signals, syscall.SIGTERM, syscall.SIGHUP)
+			defer signal.Stop(signals)
+
+			go func() {
+				for s := range signals {
+					if terminated.Store(true); !running.Load() {
+						editor.Restore()
+
+						fmt.Fprintf(cmd.ErrOrStderr(), "\nError: received %s signal\n", s)
+
+						os.Exit(exceptions.Cancelled.Code())
+					}
+				}
+			}()
+
+			var settings = map[string]string{}
+
+			for !terminated.Load() {
+				line, e := editor.Read()
+				switch {
+				case errors.Is(e, readline.ErrInterrupted):
+					continue
+				case errors.Is(e, io.EOF):
+					return nil
+				case e != nil:
+					return e
+				}
+
+				if e := history.Add(line); e != nil {
+					log.WarnContext(ctx, "Unable to persist history", slog.String("error", e.Error()))
+				}
+
+				words, open := split(line)
+				if open {
+					fmt.Fprintln(cmd.ErrOrStderr(), "Error: unterminated quote or escape")
+
+					continue
+				}
+
+				args := values(words)
+				if len(args) > 0 && args[0] == root.Name() {
+					args = args[1:]
+				}
+
+				// Skip blank lines and comments.
+				if len(args) == 0 || strings.HasPrefix(args[0], "#") {
+					continue
+				}
+
+				if args[0] == "exit" || args[0] == "quit" {
+					return nil
+				}
+
+				if e := builtin(cmd, root, history, settings, args); !errors.Is(e, errNotBuiltin) {
+					if e != nil {
+						fmt.Fprintf(cmd.ErrOrStderr(), "Error: %s\n", e)
+					}
+
+					continue
+				}
+
+				if args[0] == cmd.Name() {
+					fmt.Fprintln(cmd.ErrOrStderr(), "Error: already in a shell")
+
+					continue
+				}
+
+				// Persisted flags precede the line's, which take precedence.
+				var flags []string
+				for _, name := range slices.Sorted(maps.Keys(settings)) {
+					flags = append(flags, fmt.Sprintf("--%s=%s", name, settings[name]))
+				}
+
+				running.Store(true)
+
+				code := controls.Run(ctx, append(flags, args...))
+
+				running.Store(false)
+
+				log.Log(ctx, level.Trace.Level(), "Command completed", slog.Any("args", args), slog.Int("code", code))
+			}
+
+			return exceptions.New(exceptions.Cancelled, "session terminated by signal")
+		},
+	}
+
+	return command
+}
+
+// errNotBuiltin is returned by builtin when the command isn't one of the shell's built-ins.
+var errNotBuiltin = errors.New("not a built-in")
+
+// builtin executes the shell's built-in commands, other than "exit".
+func builtin(cmd, root *cobra.Command, history *readline.History, settings map[string]string, args []string) error {
+	switch args[0] {
+	case "set":
+		switch len(args) {
+		case 1:
+			for _, name := range slices.Sorted(maps.Keys(settings)) {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s=%s\n", name, settings[name])
+			}
+
+			return nil
+		case 3:
+			name := strings.TrimLeft(args[1], "-")
+			if root.PersistentFlags().Lookup(name) == nil {
+				return fmt.Errorf("unknown global flag %q; see \"help\"", name)
+			}
+
+			// Validate the value; the flag is restored to its default prior to the next command.
+			if e := root.PersistentFlags().Set(name, args[2]); e != nil {
+				return fmt.Errorf("invalid value %q for flag %q: %w", args[2], name, e)
+			}
+
+			settings[name] = args[2]
+
+			return nil
+		default:
+			return errors.New("usage: set [<flag> <value>]")
+		}
+	case "unset":
+		if len(args) != 2 {
+			return errors.New("usage: unset <flag>")
+		}
+
+		delete(settings, strings.TrimLeft(args[1], "-"))
+
+		return nil
+	case "history":
+		for index, entry := range history.Entries() {
+			fmt.Fprintf(cmd.OutOrStdout(), "%5d  %s\n", index+1, entry)
+		}
+
+		return nil
+	}
+
+	return errNotBuiltin
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 110,
+	})
+}
@@ -0,0 +1,178 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"template-go-cli/internal/readline"
+	"template-go-cli/internal/session"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// builtins are the shell's own commands, and their descriptions.
+var builtins = map[string]string{
+	"set":     "Persist a global flag across commands",
+	"unset":   "Remove a persisted global flag",
+	"history": "List the command history",
+	"exit":    "End the session",
+}
+
+// completer returns a [readline.Completer] for the root command's tree, reusing cobra's completion functions via
+// its hidden "__complete" command.
+func completer(ctx context.Context, root *cobra.Command, controls session.Controls) readline.Completer {
+	return func(line string) readline.Completion {
+		words, open := split(line)
+
+		// The text being completed is the final word, unless the line ends between words.
+		var partial = word{start: len(line)}
+		if n := len(words); n > 0 && (open || !strings.HasSuffix(line, " ") && !strings.HasSuffix(line, "\t")) {
+			partial, words = words[n-1], words[:n-1]
+		}
+
+		var args = values(words)
+		if len(args) > 0 && args[0] == root.Name() {
+			args = args[1:]
+		}
+
+		var completion = readline.Completion{Start: partial.start, Space: true}
+
+		switch {
+		case len(args) == 0:
+			for _, name := range slices.Sorted(maps.Keys(builtins)) {
+				if strings.HasPrefix(name, partial.value) {
+					completion.Candidates = append(completion.Candidates, readline.Candidate{Value: name, Description: builtins[name]})
+				}
+			}
+		case (args[0] == "set" || args[0] == "unset") && len(args) == 1:
+			root.PersistentFlags().VisitAll(func(flag *pflag.Flag) {
+				if strings.HasPrefix(flag.Name, partial.value) {
+					completion.Candidates = append(completion.Candidates, readline.Candidate{Value: flag.Name, Description: strings.SplitN(flag.Usage, "\n", 2)[0]})
+				}
+			})
+
+			return completion
+		case args[0] == "set" && len(args) == 2:
+			args = []string{"--" + args[1]}
+		case builtins[args[0]] != "":
+			return completion
+		}
+
+		candidates, directive := request(ctx, root, controls, append(args, partial.value))
+
+		completion.Space = directive&cobra.ShellCompDirectiveNoSpace == 0
+
+		// Complete paths as the shell completion scripts would: filtered by the candidates (as extensions), limited
+		// to directories, or when there aren't any candidates.
+		switch {
+		case directive&cobra.ShellCompDirectiveFilterFileExt != 0:
+			var extensions []string
+			for _, candidate := range candidates {
+				extensions = append(extensions, candidate.Value)
+			}
+
+			completion.Candidates = files(partial.value, extensions, false)
+		case directive&cobra.ShellCompDirectiveFilterDirs != 0:
+			completion.Candidates = files(partial.value, nil, true)
+		case len(candidates) == 0 && directive&(cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveError) == 0:
+			completion.Candidates = files(partial.value, nil, false)
+		default:
+			// Like the shell completion scripts, filter the candidates by the text being completed.
+			for _, candidate := range candidates {
+				if strings.HasPrefix(candidate.Value, partial.value) {
+					completion.Candidates = append(completion.Candidates, candidate)
+				}
+			}
+		}
+
+		// A sole directory continues into its contents.
+		if len(completion.Candidates) == 1 && strings.HasSuffix(completion.Candidates[0].Value, string(filepath.Separator)) {
+			completion.Space = false
+		}
+
+		for index := range completion.Candidates {
+			completion.Candidates[index].Value = escape(completion.Candidates[index].Value)
+		}
+
+		return completion
+	}
+}
+
+// request executes the root's "__complete" command with args, returning its candidates and directive. The
+// command's output is captured, and its logs discarded.
+func request(ctx context.Context, root *cobra.Command, controls session.Controls, args []string) ([]readline.Candidate, cobra.ShellCompDirective) {
+	var buffer bytes.Buffer
+
+	stdout, stderr := root.OutOrStdout(), root.ErrOrStderr()
+
+	root.SetOut(&buffer)
+	root.SetErr(io.Discard)
+
+	defer func() {
+		root.SetOut(stdout)
+		root.SetErr(stderr)
+	}()
+
+	if controls.Run(ctx, append([]string{cobra.ShellCompRequestCmd}, args...)) != 0 {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var (
+		candidates []readline.Candidate
+		directive  = cobra.ShellCompDirectiveDefault
+	)
+
+	for _, line := range strings.Split(strings.TrimRight(buffer.String(), "\n"), "\n") {
+		switch {
+		case line == "" || strings.HasPrefix(line, "_activeHelp_"):
+			continue
+		case strings.HasPrefix(line, ":"):
+			if v, e := strconv.Atoi(line[1:]); e == nil {
+				directive = cobra.ShellCompDirective(v)
+			}
+		default:
+			value, description, _ := strings.Cut(line, "\t")
+
+			candidates = append(candidates, readline.Candidate{Value: value, Description: description})
+		}
+	}
+
+	return candidates, directive
+}
+
+// files returns the paths matching partial, as candidates. Directories are suffixed with a separator. Files are
+// omitted when directories is set, or don't have one of the extensions, if any.
+func files(partial string, extensions []string, directories bool) []readline.Candidate {
+	matches, _ := filepath.Glob(partial + "*")
+
+	var candidates []readline.Candidate
+
+	for _, match := range matches {
+		information, e := os.Stat(match)
+		if e != nil {
+			continue
+		}
+
+		if information.IsDir() {
+			candidates = append(candidates, readline.Candidate{Value: match + string(filepath.Separator)})
+
+			continue
+		}
+
+		if directories || (len(extensions) > 0 && !slices.Contains(extensions, strings.TrimPrefix(filepath.Ext(match), "."))) {
+			continue
+		}
+
+		candidates = append(candidates, readline.Candidate{Value: match})
+	}
+
+	return candidates
+}
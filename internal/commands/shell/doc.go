@@ -0,0 +1,2 @@
+// Package shell provides the shell cli sub-command: an interactive session over the command tree.
+package shell
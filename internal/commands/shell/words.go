@@ -0,0 +1,82 @@
+package shell
+
+import (
+	"strings"
+)
+
+// word is a command-line word and the byte offset, within the line, at which it starts.
+type word struct {
+	value string
+	start int
+}
+
+// split splits a command-line into words, honoring single quotes, double quotes and backslash escapes (outside
+// single quotes). An unterminated quote or trailing backslash is reported via open; the final word is then partial.
+func split(line string) (words []word, open bool) {
+	var (
+		current strings.Builder
+		started = -1 // started is the offset of the current word; -1 between words.
+		quote   rune // quote is the enclosing quote, if any.
+		escaped bool // escaped reports whether the preceding rune was an unquoted backslash.
+	)
+
+	for offset, r := range line {
+		if started < 0 && !(r == ' ' || r == '\t') {
+			started = offset
+		}
+
+		switch {
+		case escaped:
+			current.WriteRune(r)
+
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == ' ' || r == '\t':
+			if started >= 0 {
+				words = append(words, word{value: current.String(), start: started})
+
+				current.Reset()
+
+				started = -1
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if started >= 0 {
+		words = append(words, word{value: current.String(), start: started})
+	}
+
+	return words, quote != 0 || escaped
+}
+
+// escape escapes the characters split treats specially, so that value is read as a single word.
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, " ", `\ `, "\t", "\\\t", "'", `\'`, `"`, `\"`).Replace(value)
+}
+
+// values returns the words' values.
+func values(words []word) []string {
+	var values = make([]string, len(words))
+	for index, w := range words {
+		values[index] = w.value
+	}
+
+	return values
+}
@@ -0,0 +1,53 @@
+package shell
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		line   string
+		want   []string
+		starts []int
+		open   bool
+	}{
+		{line: "", want: nil},
+		{line: "  version  --short ", want: []string{"version", "--short"}, starts: []int{2, 11}},
+		{line: `example -n "a b"`, want: []string{"example", "-n", "a b"}, starts: []int{0, 8, 11}},
+		{line: `example -n 'a "b"'`, want: []string{"example", "-n", `a "b"`}, starts: []int{0, 8, 11}},
+		{line: `a\ b "c\"d" 'e\f'`, want: []string{"a b", `c"d`, `e\f`}, starts: []int{0, 5, 12}},
+		{line: `x""y ''`, want: []string{"xy", ""}, starts: []int{0, 5}},
+		{line: `example -n 'unterminated`, want: []string{"example", "-n", "unterminated"}, starts: []int{0, 8, 11}, open: true},
+		{line: `trailing\`, want: []string{"trailing"}, starts: []int{0}, open: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			words, open := split(test.line)
+
+			if got := values(words); !slices.Equal(got, test.want) {
+				t.Fatalf("expected %q, received %q", test.want, got)
+			}
+
+			for index, w := range words {
+				if w.start != test.starts[index] {
+					t.Errorf("expected word %d to start at %d, received %d", index, test.starts[index], w.start)
+				}
+			}
+
+			if open != test.open {
+				t.Errorf("expected open %t, received %t", test.open, open)
+			}
+		})
+	}
+}
+
+func TestEscape(t *testing.T) {
+	for _, value := range []string{"plain", "with space", `quote's "double"`, `back\slash`, "tab\there"} {
+		words, open := split("prefix " + escape(value))
+		if open || len(words) != 2 || words[1].value != value {
+			t.Errorf("expected %q to round-trip, received %q (open: %t)", value, values(words), open)
+		}
+	}
+}
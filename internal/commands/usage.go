@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"errors"
+
+	"template-go-cli/internal/exceptions"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// grouping is the annotation marking commands that only group sub-commands; see [classify].
+const grouping = "commands.grouping"
+
+// Runnable reports whether the command performs an action of its own, rather than only grouping sub-commands (whose
+// execution, without arguments, prints the command's help).
+func Runnable(cmd *cobra.Command) bool {
+	_, grouped := cmd.Annotations[grouping]
+
+	return cmd.Runnable() && !grouped
+}
+
+// classify prepares the command and, recursively, its sub-commands so that cobra's (otherwise unstructured) usage
+// errors are [exceptions.Usage] exceptions:
+//
+//   - Commands that only group sub-commands print their help when executed without arguments, and reject any others
+//     as unknown commands. Cobra would otherwise print the help for an unknown sub-command and succeed.
+//   - Positional argument validation (the commands' [cobra.PositionalArgs]) errors are wrapped.
+//
+// Flag parsing errors are classified via the root's flag error function -- or, for the flags of commands traversed
+// through to the executed one, see [parsing] -- and required flag and flag group errors via its persistent pre-run;
+// see [New].
+func classify(cmd *cobra.Command) {
+	for _, child := range cmd.Commands() {
+		classify(child)
+	}
+
+	if cmd.HasSubCommands() && !cmd.Runnable() {
+		if cmd.Annotations == nil {
+			cmd.Annotations = map[string]string{}
+		}
+
+		cmd.Annotations[grouping] = "true"
+
+		cmd.Args = unknown
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		}
+
+		return
+	}
+
+	if validate := cmd.Args; validate != nil {
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			if e := validate(cmd, args); e != nil {
+				return usage(cmd, e)
+			}
+
+			return nil
+		}
+	}
+}
+
+// unknown is the [cobra.PositionalArgs] of commands grouping sub-commands: any argument is an unknown command.
+func unknown(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	exception := exceptions.New(exceptions.Usage, "unknown command %q for %q", args[0], cmd.CommandPath())
+
+	if suggestions := cmd.SuggestionsFor(args[0]); len(suggestions) > 0 {
+		return exception.WithHint("did you mean %q? see \"%s --help\" for usage", suggestions[0], cmd.CommandPath())
+	}
+
+	return exception.WithHint("see \"%s --help\" for usage", cmd.CommandPath())
+}
+
+// usage wraps the command's usage error as an [exceptions.Usage] exception.
+func usage(cmd *cobra.Command, e error) error {
+	return exceptions.Wrap(exceptions.Usage, e, "").WithHint("see \"%s --help\" for usage", cmd.CommandPath())
+}
+
+// parsing reports whether e is a flag parsing error that isn't yet classified. As the root traverses its children
+// (see [cobra.Command.TraverseChildren]), cobra returns the parsing errors of the flags preceding the executed
+// command (e.g. "--log-level bad version") as-is, without the flag error function.
+func parsing(e error) bool {
+	var exception *exceptions.Exception
+	if e == nil || errors.As(e, &exception) {
+		return false
+	}
+
+	var (
+		missing  *pflag.NotExistError
+		required *pflag.ValueRequiredError
+		invalid  *pflag.InvalidValueError
+		syntax   *pflag.InvalidSyntaxError
+	)
+
+	return errors.As(e, &missing) || errors.As(e, &required) || errors.As(e, &invalid) || errors.As(e, &syntax)
+}
@@ -0,0 +1,47 @@
+package commands_test
+
+import (
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/harness"
+)
+
+func TestUsage(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stderr string
+	}{
+		{name: "root", args: []string{}, code: 0},
+		{name: "group", args: []string{"plugin"}, code: 0},
+		{name: "unknown-command", args: []string{"nope"}, code: 2, stderr: `unknown command "nope" for "template-go-cli"`},
+		{name: "unknown-subcommand", args: []string{"plugin", "nope"}, code: 2, stderr: `unknown command "nope" for "template-go-cli plugin"`},
+		{name: "suggestion", args: []string{"plugn"}, code: 2, stderr: `did you mean "plugin"?`},
+		{name: "unexpected-argument", args: []string{"version", "extra"}, code: 2, stderr: `unknown command "extra" for "template-go-cli version"`},
+		{name: "too-many-arguments", args: []string{"lint", "commit", "a", "b"}, code: 2, stderr: "accepts at most 1 arg(s)"},
+		{name: "unknown-flag", args: []string{"version", "--missing"}, code: 2, stderr: "unknown flag: --missing"},
+		{name: "invalid-root-flag", args: []string{"-z", "bad", "version"}, code: 2, stderr: `invalid argument "bad" for "-z, --log-level" flag`},
+		{name: "unknown-root-flag", args: []string{"--missing", "version"}, code: 2, stderr: "unknown flag: --missing"},
+		{name: "required-flag", args: []string{"example"}, code: 2, stderr: `required flag(s) "name" not set`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := harness.Run(t, harness.Options{Args: test.args})
+
+			if result.Code != test.code {
+				t.Fatalf("expected exit code %d, received %d; standard-error:\n%s", test.code, result.Code, result.Stderr)
+			}
+
+			if !strings.Contains(result.Stderr, test.stderr) {
+				t.Fatalf("expected standard-error to contain %q; received:\n%s", test.stderr, result.Stderr)
+			}
+
+			if test.code == 0 && !strings.Contains(result.Stdout, "Usage:") {
+				t.Fatalf("expected the command's help; received:\n%s", result.Stdout)
+			}
+		})
+	}
+}
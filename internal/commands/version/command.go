@@ -0,0 +1,170 @@
+package version
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/flags/format"
+	"template-go-cli/internal/logging"
+	"template-go-cli/internal/result"
+	"template-go-cli/internal/types/level"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Dependency represents a module dependency compiled into the executable.
+type Dependency struct {
+	Path    string `json:"path" yaml:"path"`
+	Version string `json:"version" yaml:"version"`
+	Sum     string `json:"sum,omitempty" yaml:"sum,omitempty"`
+	Replace string `json:"replace,omitempty" yaml:"replace,omitempty"`
+}
+
+// VCS represents the version control information stamped by the go toolchain.
+type VCS struct {
+	System   string `json:"system,omitempty" yaml:"system,omitempty"`
+	Revision string `json:"revision,omitempty" yaml:"revision,omitempty"`
+	Time     string `json:"time,omitempty" yaml:"time,omitempty"`
+	Modified bool   `json:"modified" yaml:"modified"`
+}
+
+// Information represents the version command's output.
+type Information struct {
+	Version      string            `json:"version" yaml:"version"`
+	Commit       string            `json:"commit" yaml:"commit"`
+	Date         string            `json:"date" yaml:"date"`
+	Sources      string            `json:"sources" yaml:"sources"`
+	Go           string            `json:"go" yaml:"go"`
+	Platform     string            `json:"platform" yaml:"platform"`
+	Module       string            `json:"module,omitempty" yaml:"module,omitempty"`
+	VCS          *VCS              `json:"vcs,omitempty" yaml:"vcs,omitempty"`
+	Settings     map[string]string `json:"settings,omitempty" yaml:"settings,omitempty"`
+	Dependencies []Dependency      `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
+}
+
+// New returns the version command.
+func New() *cobra.Command {
+	var (
+		short bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "version",
+		Short: "Display version and build information",
+		Long:  "Displays the executable's version, commit, build date and source-location logging setting -- as injected at link time -- along with the go toolchain's embedded build information, including module dependencies, VCS state and build settings.",
+		Example: strings.Join([]string{
+			fmt.Sprintf("  %s", "# Display full build information"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s version --output yaml", constants.Name)),
+			"",
+			fmt.Sprintf("  %s", "# Display only the version"),
+			fmt.Sprintf("  %s", fmt.Sprintf("%s version --short", constants.Name)),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var log = logging.Get(ctx)
+
+			log.Log(ctx, level.Trace.Level(), "Running version command")
+
+			if build.Version == "0.0.0" || build.Commit == "n/a" || build.Date == "latest" {
+				log.WarnContext(ctx, "Build metadata was not set via -ldflags; values are placeholders", slog.String("version", build.Version), slog.String("commit", build.Commit), slog.String("date", build.Date))
+			}
+
+			var information = Get()
+
+			result.Record(ctx, information)
+
+			if short {
+				fmt.Fprintln(cmd.OutOrStdout(), build.Version)
+
+				return nil
+			}
+
+			buffer, e := output.Write(format.Get(ctx), information)
+			if e != nil {
+				return e
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", buffer.String())
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.BoolVarP(&short, "short", "s", false, "display only the version")
+
+	return command
+}
+
+// Get assembles the executable's [Information] from [build] and [debug.ReadBuildInfo].
+func Get() Information {
+	var information = Information{
+		Version:  build.Version,
+		Commit:   build.Commit,
+		Date:     build.Date,
+		Sources:  build.Sources,
+		Go:       runtime.Version(),
+		Platform: fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+	}
+
+	info, available := debug.ReadBuildInfo()
+	if !available {
+		return information
+	}
+
+	information.Module = info.Main.Path
+	information.Settings = map[string]string{}
+
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs":
+			information.vcs().System = setting.Value
+		case "vcs.revision":
+			information.vcs().Revision = setting.Value
+		case "vcs.time":
+			information.vcs().Time = setting.Value
+		case "vcs.modified":
+			information.vcs().Modified, _ = strconv.ParseBool(setting.Value)
+		default:
+			information.Settings[setting.Key] = setting.Value
+		}
+	}
+
+	for _, dependency := range info.Deps {
+		var d = Dependency{Path: dependency.Path, Version: dependency.Version, Sum: dependency.Sum}
+		if dependency.Replace != nil {
+			d.Replace = fmt.Sprintf("%s@%s", dependency.Replace.Path, dependency.Replace.Version)
+		}
+
+		information.Dependencies = append(information.Dependencies, d)
+	}
+
+	return information
+}
+
+// vcs lazily initializes and returns the information's [VCS] section.
+func (i *Information) vcs() *VCS {
+	if i.VCS == nil {
+		i.VCS = &VCS{}
+	}
+
+	return i.VCS
+}
+
+func init() {
+	registry.Register(registry.Registration{
+		New:   New,
+		Order: 100,
+	})
+}
@@ -0,0 +1,2 @@
+// Package version provides the cli sub-command reporting the executable's build metadata.
+package version
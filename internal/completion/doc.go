@@ -0,0 +1,6 @@
+// Package completion installs and uninstalls shell completion scripts in per-user locations.
+//
+// Each shell's script is loaded by a single mechanism: fish auto-loads it from its completions directory, zsh from
+// an fpath directory the rc file adds, and bash and PowerShell source it from their rc file. Rc files are --
+// idempotently -- amended with a marked block; uninstalling removes both the script and the marked block.
+package completion
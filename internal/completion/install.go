@@ -0,0 +1,213 @@
+package completion
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/paths"
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// Target describes where a shell's completion script is installed.
+type Target struct {
+	Shell   Shell  `json:"shell" yaml:"shell"`
+	Script  string `json:"script" yaml:"script"`                       // Script is the completion script's path.
+	RC      string `json:"rc,omitempty" yaml:"rc,omitempty"`           // RC is the shell's rc file; empty when the script is auto-loaded.
+	Snippet string `json:"snippet,omitempty" yaml:"snippet,omitempty"` // Snippet is the rc file content that loads the script.
+}
+
+// Markers delimiting the managed block within an rc file.
+var (
+	begin = fmt.Sprintf("# >>> %s completion >>>", constants.Name)
+	end   = fmt.Sprintf("# <<< %s completion <<<", constants.Name)
+)
+
+// Locate resolves the shell's per-user [Target] from the context's environment (see [system.Get]).
+func Locate(ctx context.Context, shell Shell) (Target, error) {
+	home, e := paths.Home(ctx)
+	if e != nil {
+		return Target{}, fmt.Errorf("unable to resolve home directory: %w", e)
+	}
+
+	var s = system.Get(ctx)
+
+	data := s.Getenv("XDG_DATA_HOME")
+	if data == "" {
+		data = filepath.Join(home, ".local", "share")
+	}
+
+	configuration := s.Getenv("XDG_CONFIG_HOME")
+	if configuration == "" {
+		configuration = filepath.Join(home, ".config")
+	}
+
+	var target = Target{Shell: shell}
+
+	switch shell {
+	case Bash:
+		// The script is sourced by the rc file, rather than auto-loaded via bash-completion's directory (which isn't
+		// always available), so it's kept outside of that directory to avoid loading it twice.
+		target.Script = filepath.Join(data, constants.Name, "completions", constants.Name+".bash")
+		target.RC = filepath.Join(home, ".bashrc")
+		target.Snippet = fmt.Sprintf("[ -f %[1]q ] && source %[1]q", target.Script)
+	case Zsh:
+		// The script is auto-loaded from the fpath by the user's own compinit; the block only extends the fpath, so
+		// it's placed at the start of the rc file, ahead of compinit.
+		target.Script = filepath.Join(home, ".zfunc", "_"+constants.Name)
+		target.RC = filepath.Join(home, ".zshrc")
+		target.Snippet = fmt.Sprintf("fpath=(%q $fpath)", filepath.Dir(target.Script))
+	case Fish:
+		// Fish auto-loads completions from its completions directory; no rc file changes are necessary.
+		target.Script = filepath.Join(configuration, "fish", "completions", constants.Name+".fish")
+	case PowerShell:
+		profile := filepath.Join(configuration, "powershell")
+		if runtime.GOOS == "windows" {
+			profile = filepath.Join(home, "Documents", "PowerShell")
+		}
+
+		target.Script = filepath.Join(profile, "Completions", constants.Name+".ps1")
+		target.RC = filepath.Join(profile, "Microsoft.PowerShell_profile.ps1")
+		target.Snippet = fmt.Sprintf(". %q", target.Script)
+	default:
+		return Target{}, fmt.Errorf("unsupported shell %q", shell)
+	}
+
+	return target, nil
+}
+
+// Install writes the shell's completion script and upserts the managed rc file block.
+func Install(ctx context.Context, root *cobra.Command, shell Shell) (Target, error) {
+	target, e := Locate(ctx, shell)
+	if e != nil {
+		return target, e
+	}
+
+	var buffer bytes.Buffer
+	if e := shell.Generate(root, &buffer, true); e != nil {
+		return target, fmt.Errorf("unable to generate %s completion script: %w", shell, e)
+	}
+
+	var filesystem = system.Get(ctx).Filesystem
+
+	if e := filesystem.MkdirAll(filepath.Dir(target.Script), 0o755); e != nil {
+		return target, fmt.Errorf("unable to create completion directory: %w", e)
+	}
+
+	if e := filesystem.WriteFile(target.Script, buffer.Bytes(), 0o644); e != nil {
+		return target, fmt.Errorf("unable to write completion script: %w", e)
+	}
+
+	if target.RC == "" {
+		return target, nil
+	}
+
+	return target, edit(filesystem, target.RC, target.Snippet, shell == Zsh)
+}
+
+// Uninstall removes the shell's completion script and the managed rc file block, if present.
+func Uninstall(ctx context.Context, shell Shell) (Target, error) {
+	target, e := Locate(ctx, shell)
+	if e != nil {
+		return target, e
+	}
+
+	var filesystem = system.Get(ctx).Filesystem
+
+	if e := filesystem.Remove(target.Script); e != nil && !errors.Is(e, fs.ErrNotExist) {
+		return target, fmt.Errorf("unable to remove completion script: %w", e)
+	}
+
+	if target.RC == "" {
+		return target, nil
+	}
+
+	return target, edit(filesystem, target.RC, "", false)
+}
+
+// Installed returns the [Target] of every shell with an installed completion script.
+func Installed(ctx context.Context) []Target {
+	var targets []Target
+
+	for _, shell := range Shells {
+		target, e := Locate(ctx, shell)
+		if e != nil {
+			continue
+		}
+
+		if _, e := system.Get(ctx).Filesystem.Stat(target.Script); e == nil {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
+
+// edit replaces (or removes, if snippet is empty) the managed block of the rc file at path. The block is appended,
+// or prepended if first is true. The file is created when a snippet is added to a non-existent file, and left
+// untouched if its content doesn't change.
+func edit(filesystem system.Filesystem, path, snippet string, first bool) error {
+	content, e := filesystem.ReadFile(path)
+	if e != nil && !errors.Is(e, fs.ErrNotExist) {
+		return fmt.Errorf("unable to read %q: %w", path, e)
+	}
+
+	updated := strip(string(content))
+	if snippet != "" {
+		block := strings.Join([]string{begin, snippet, end}, "\n") + "\n"
+
+		switch {
+		case first:
+			updated = block + updated
+		case updated != "" && !strings.HasSuffix(updated, "\n"):
+			updated += "\n" + block
+		default:
+			updated += block
+		}
+	}
+
+	if updated == string(content) {
+		return nil
+	}
+
+	if e := filesystem.MkdirAll(filepath.Dir(path), 0o755); e != nil {
+		return fmt.Errorf("unable to create %q: %w", filepath.Dir(path), e)
+	}
+
+	if e := filesystem.WriteFile(path, []byte(updated), 0o644); e != nil {
+		return fmt.Errorf("unable to write %q: %w", path, e)
+	}
+
+	return nil
+}
+
+// strip removes every managed block from content.
+func strip(content string) string {
+	var lines []string
+	var managed bool
+
+	for _, line := range strings.SplitAfter(content, "\n") {
+		switch strings.TrimSpace(line) {
+		case begin:
+			managed = true
+			continue
+		case end:
+			managed = false
+			continue
+		}
+
+		if !managed {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "")
+}
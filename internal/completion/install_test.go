@@ -0,0 +1,108 @@
+package completion
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// isolate returns a context whose system points the home and XDG directories at a temporary directory.
+func isolate(t *testing.T) (context.Context, string) {
+	home := t.TempDir()
+
+	s := system.Host()
+	s.Env = system.Environment(map[string]string{"HOME": home, "USERPROFILE": home})
+
+	return system.With(context.Background(), s), home
+}
+
+func TestInstall(t *testing.T) {
+	tests := []struct {
+		shell    Shell
+		rc       string
+		snippet  string
+		first    bool
+		excluded []string
+	}{
+		{shell: Bash, rc: ".bashrc", snippet: "source", excluded: []string{"bash-completion"}},
+		{shell: Zsh, rc: ".zshrc", snippet: "fpath=(", first: true, excluded: []string{"compinit"}},
+		{shell: Fish},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.shell), func(t *testing.T) {
+			ctx, home := isolate(t)
+
+			root := &cobra.Command{Use: "root"}
+
+			// The rc file's existing content is retained, and repeated installs don't duplicate the block.
+			if test.rc != "" {
+				if e := os.WriteFile(filepath.Join(home, test.rc), []byte("existing\n"), 0o644); e != nil {
+					t.Fatal(e)
+				}
+			}
+
+			var target Target
+
+			for range 2 {
+				var e error
+				if target, e = Install(ctx, root, test.shell); e != nil {
+					t.Fatalf("unable to install: %v", e)
+				}
+			}
+
+			if _, e := os.Stat(target.Script); e != nil {
+				t.Fatalf("expected the completion script to be written: %v", e)
+			}
+
+			for _, excluded := range test.excluded {
+				if strings.Contains(target.Script, excluded) || strings.Contains(target.Snippet, excluded) {
+					t.Errorf("expected neither the script %q nor the snippet %q to contain %q", target.Script, target.Snippet, excluded)
+				}
+			}
+
+			if test.rc == "" {
+				if target.RC != "" {
+					t.Fatalf("expected no rc file; received %q", target.RC)
+				}
+
+				return
+			}
+
+			content, e := os.ReadFile(target.RC)
+			if e != nil {
+				t.Fatal(e)
+			}
+
+			if count := strings.Count(string(content), begin); count != 1 {
+				t.Fatalf("expected a single managed block; received %d:\n%s", count, content)
+			}
+
+			if !strings.Contains(string(content), test.snippet) || !strings.Contains(string(content), "existing\n") {
+				t.Fatalf("unexpected rc file:\n%s", content)
+			}
+
+			if strings.HasPrefix(string(content), begin) != test.first {
+				t.Fatalf("expected the managed block to be first (%t):\n%s", test.first, content)
+			}
+
+			if _, e := Uninstall(ctx, test.shell); e != nil {
+				t.Fatalf("unable to uninstall: %v", e)
+			}
+
+			if content, _ := os.ReadFile(target.RC); string(content) != "existing\n" {
+				t.Fatalf("expected the managed block to be removed:\n%s", content)
+			}
+
+			if _, e := os.Stat(target.Script); e == nil {
+				t.Fatal("expected the completion script to be removed")
+			}
+		})
+	}
+}
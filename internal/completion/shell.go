@@ -0,0 +1,81 @@
+package completion
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// Shell represents a supported shell.
+type Shell string
+
+const (
+	Bash       Shell = "bash"
+	Zsh        Shell = "zsh"
+	Fish       Shell = "fish"
+	PowerShell Shell = "powershell"
+)
+
+// Shells lists every supported shell.
+var Shells = []Shell{Bash, Zsh, Fish, PowerShell}
+
+// Parse converts a shell name into a [Shell].
+func Parse(name string) (Shell, error) {
+	switch Shell(strings.ToLower(name)) {
+	case Bash:
+		return Bash, nil
+	case Zsh:
+		return Zsh, nil
+	case Fish:
+		return Fish, nil
+	case PowerShell, "pwsh":
+		return PowerShell, nil
+	default:
+		return "", fmt.Errorf("unsupported shell %q", name)
+	}
+}
+
+// Detect determines the user's shell from the context's "SHELL" environment variable, defaulting to PowerShell on
+// windows.
+func Detect(ctx context.Context) (Shell, error) {
+	if v := system.Get(ctx).Getenv("SHELL"); v != "" {
+		return Parse(strings.TrimSuffix(filepath.Base(v), ".exe"))
+	}
+
+	if runtime.GOOS == "windows" {
+		return PowerShell, nil
+	}
+
+	return "", fmt.Errorf("unable to detect shell; \"SHELL\" environment variable is unset")
+}
+
+// Generate writes the shell's completion script, including descriptions, for the root command to w.
+func (s Shell) Generate(root *cobra.Command, w io.Writer, descriptions bool) error {
+	switch s {
+	case Bash:
+		return root.GenBashCompletionV2(w, descriptions)
+	case Zsh:
+		if descriptions {
+			return root.GenZshCompletion(w)
+		}
+
+		return root.GenZshCompletionNoDesc(w)
+	case Fish:
+		return root.GenFishCompletion(w, descriptions)
+	case PowerShell:
+		if descriptions {
+			return root.GenPowerShellCompletionWithDesc(w)
+		}
+
+		return root.GenPowerShellCompletion(w)
+	default:
+		return fmt.Errorf("unsupported shell %q", s)
+	}
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/paths"
+	"template-go-cli/internal/system"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Config represents the configuration file's contents.
+type Config struct {
+	Features     []string `json:"features,omitempty" yaml:"features,omitempty"` // Features enables named, feature-gated commands.
+	Experimental bool     `json:"experimental" yaml:"experimental"`             // Experimental enables experimental commands.
+	Update       Update   `json:"update" yaml:"update"`                         // Update configures release update checks.
+}
+
+// Update configures the "self-update" command and the update-available notifier.
+type Update struct {
+	Feed   string `json:"feed,omitempty" yaml:"feed,omitempty"` // Feed is the release feed; see the "self-update" command.
+	Silent bool   `json:"silent" yaml:"silent"`                 // Silent opts out of the update-available notice.
+}
+
+// Path returns the configuration file's path, resolved from the context's environment (see [system.Get]).
+func Path(ctx context.Context) (string, error) {
+	if v := system.Get(ctx).Getenv(constants.Prefix + "_CONFIG"); v != "" {
+		return v, nil
+	}
+
+	directory, e := paths.Config(ctx)
+	if e != nil {
+		return "", e
+	}
+
+	return filepath.Join(directory, "config.yaml"), nil
+}
+
+// Load reads -- via the context's filesystem -- and strictly parses the configuration file at path; unknown fields
+// are errors. A missing file returns the default configuration.
+func Load(ctx context.Context, path string) (*Config, error) {
+	var configuration = &Config{}
+
+	content, e := system.Get(ctx).Filesystem.ReadFile(path)
+	if errors.Is(e, fs.ErrNotExist) {
+		return configuration, nil
+	} else if e != nil {
+		return nil, fmt.Errorf("unable to read configuration file: %w", e)
+	}
+
+	if e := yaml.UnmarshalWithOptions(content, configuration, yaml.Strict(), yaml.DisallowUnknownField()); e != nil {
+		return nil, fmt.Errorf("unable to parse configuration file %q: %w", path, e)
+	}
+
+	return configuration, nil
+}
+
+// Current loads the configuration file from [Path]. It's read on each call, as the context's environment and
+// filesystem may differ between (e.g. in-process) executions.
+func Current(ctx context.Context) (*Config, error) {
+	path, e := Path(ctx)
+	if e != nil {
+		return nil, e
+	}
+
+	return Load(ctx, path)
+}
@@ -0,0 +1,5 @@
+// Package config loads the cli's optional, per-user yaml configuration file.
+//
+// The file is read from "config.yaml" in the configuration directory (see [paths.Config]), or from the path set
+// via the "<PREFIX>_CONFIG" environment variable. A missing file is not an error; defaults apply.
+package config
@@ -4,4 +4,7 @@ package constants
 const (
 	//
 	Name = "template-go-cli"
+
+	// Prefix represents the environment variable prefix derived from [Name] (e.g. "TEMPLATE_GO_CLI_LOG_LEVEL").
+	Prefix = "TEMPLATE_GO_CLI"
 )
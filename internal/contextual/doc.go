@@ -0,0 +1,3 @@
+// Package contextual provides strictly typed [context.Context] keys, replacing per-package key types and unchecked
+// type assertions of [context.Context.Value] results.
+package contextual
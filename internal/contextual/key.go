@@ -0,0 +1,61 @@
+package contextual
+
+import (
+	"context"
+	"fmt"
+)
+
+// Key is a typed [context.Context] key. Each Key is distinct, by pointer identity, regardless of its name.
+type Key[T any] struct {
+	name     string
+	fallback func() T
+}
+
+// New returns a [Key] for values of type T. The name is only used for diagnostics. The optional fallback provides
+// [Key.Value]'s default when the context doesn't hold a value; it's evaluated on each use.
+func New[T any](name string, fallback func() T) *Key[T] {
+	return &Key[T]{name: name, fallback: fallback}
+}
+
+// String returns the key's name.
+func (k *Key[T]) String() string {
+	return k.name
+}
+
+// With returns a copy of ctx holding v.
+func (k *Key[T]) With(ctx context.Context, v T) context.Context {
+	return context.WithValue(ctx, k, v)
+}
+
+// Get returns the value held by ctx, and whether one was present.
+func (k *Key[T]) Get(ctx context.Context) (T, bool) {
+	v, ok := ctx.Value(k).(T)
+
+	return v, ok
+}
+
+// Value returns the value held by ctx; otherwise, the key's fallback -- or T's zero value if there isn't one.
+func (k *Key[T]) Value(ctx context.Context) T {
+	if v, ok := k.Get(ctx); ok {
+		return v
+	}
+
+	if k.fallback != nil {
+		return k.fallback()
+	}
+
+	var zero T
+
+	return zero
+}
+
+// MustGet returns the value held by ctx, panicking with a descriptive message if there isn't one. It's intended for
+// values whose absence is a programming error.
+func (k *Key[T]) MustGet(ctx context.Context) T {
+	v, ok := k.Get(ctx)
+	if !ok {
+		panic(fmt.Sprintf("context value %q of type %T is not set", k.name, v))
+	}
+
+	return v
+}
@@ -0,0 +1,48 @@
+package contextual
+
+import (
+	"context"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	var name = New("name", func() string { return "fallback" })
+	var count = New[int]("count", nil)
+
+	ctx := context.Background()
+
+	if v, ok := name.Get(ctx); ok || v != "" {
+		t.Errorf("expected no value, received %q", v)
+	}
+
+	if v := name.Value(ctx); v != "fallback" {
+		t.Errorf("expected fallback, received %q", v)
+	}
+
+	if v := count.Value(ctx); v != 0 {
+		t.Errorf("expected zero value, received %d", v)
+	}
+
+	ctx = count.With(name.With(ctx, "value"), 3)
+
+	if v, ok := name.Get(ctx); !ok || v != "value" {
+		t.Errorf("expected \"value\", received %q (%t)", v, ok)
+	}
+
+	if v := count.MustGet(ctx); v != 3 {
+		t.Errorf("expected 3, received %d", v)
+	}
+
+	// Keys are distinct by identity, even when sharing a name and type.
+	if v, ok := New[string]("name", nil).Get(ctx); ok {
+		t.Errorf("expected a distinct key, received %q", v)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered == nil {
+			t.Error("expected MustGet to panic")
+		}
+	}()
+
+	New[bool]("missing", nil).MustGet(ctx)
+}
@@ -0,0 +1,171 @@
+package conventional
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Types lists the recognized commit types and their descriptions, per ".gitmessage".
+var Types = map[string]string{
+	"build":    "Changes that affect the build system or external dependencies",
+	"ci":       "Changes to CI configuration files and scripts",
+	"docs":     "Documentation only changes",
+	"feat":     "A new feature",
+	"fix":      "A bug fix",
+	"perf":     "A code change that improves performance",
+	"refactor": "A code change that neither fixes a bug nor adds a feature",
+	"style":    "Changes that do not affect the meaning of the code",
+	"test":     "Adding missing tests or correcting existing tests",
+	"bump":     "Version bump a package, module or upgrade a dependency",
+	"chore":    "Configuration, scripts, formatting, clean-up, other - non-production code change(s)",
+	"revert":   "Reverts a previous commit",
+}
+
+// Footer represents a git-trailer style footer (e.g. "Refs: #123").
+type Footer struct {
+	Token string `json:"token" yaml:"token"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// Commit represents a parsed conventional commit message.
+type Commit struct {
+	Type        string   `json:"type" yaml:"type"`
+	Scope       string   `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Breaking    bool     `json:"breaking" yaml:"breaking"` // Breaking is true for a "!" header marker or a "BREAKING CHANGE" footer.
+	Description string   `json:"description" yaml:"description"`
+	Body        string   `json:"body,omitempty" yaml:"body,omitempty"`
+	Footers     []Footer `json:"footers,omitempty" yaml:"footers,omitempty"`
+}
+
+// Notes returns the values of the commit's "BREAKING CHANGE" (or "BREAKING-CHANGE") footers.
+func (c Commit) Notes() []string {
+	var notes []string
+	for _, footer := range c.Footers {
+		if Breaking(footer.Token) {
+			notes = append(notes, footer.Value)
+		}
+	}
+
+	return notes
+}
+
+var (
+	// Header matches a conventional commit header; sub-matches are the type, the parenthesized scope, the scope,
+	// the breaking marker and the description.
+	Header = regexp.MustCompile(`^([a-zA-Z]+)(\(([^()\r\n]*)\))?(!)?: (.*)$`)
+
+	// Trailer matches the first line of a footer; sub-matches are the token, the separator and the value.
+	Trailer = regexp.MustCompile(`^(BREAKING CHANGE|BREAKING-CHANGE|[A-Za-z][A-Za-z0-9-]*)(: | #)(.*)$`)
+)
+
+// Breaking reports whether a footer token denotes a breaking change.
+func Breaking(token string) bool {
+	return token == "BREAKING CHANGE" || token == "BREAKING-CHANGE"
+}
+
+// Clean strips git comment lines (beginning with "#") and trailing whitespace, as "git commit" does with its
+// default cleanup mode. Everything following a scissors line is discarded.
+func Clean(message string) string {
+	var lines []string
+
+	for _, line := range strings.Split(strings.ReplaceAll(message, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(line, "# ------------------------ >8 ------------------------") {
+			break
+		}
+
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// Parse parses a commit message. The message is expected to be [Clean]; the header is the first line, the body
+// follows a blank line, and the footers are the trailing paragraph whose first line matches [Trailer].
+func Parse(message string) (Commit, error) {
+	var commit Commit
+
+	lines := strings.Split(strings.Trim(message, "\n"), "\n")
+
+	match := Header.FindStringSubmatch(lines[0])
+	if match == nil {
+		return commit, fmt.Errorf("invalid conventional commit header %q", lines[0])
+	}
+
+	commit.Type = strings.ToLower(match[1])
+	commit.Scope = match[3]
+	commit.Breaking = match[4] == "!"
+	commit.Description = strings.TrimSpace(match[5])
+
+	if len(lines) < 2 {
+		return commit, nil
+	}
+
+	// Split the remainder into blank-line separated paragraphs.
+	var paragraphs [][]string
+	var current []string
+	for _, line := range lines[1:] {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				paragraphs = append(paragraphs, current)
+				current = nil
+			}
+
+			continue
+		}
+
+		current = append(current, line)
+	}
+
+	if len(current) > 0 {
+		paragraphs = append(paragraphs, current)
+	}
+
+	if len(paragraphs) > 0 && Trailer.MatchString(paragraphs[len(paragraphs)-1][0]) {
+		commit.Footers = footers(paragraphs[len(paragraphs)-1])
+		paragraphs = paragraphs[:len(paragraphs)-1]
+	}
+
+	var body []string
+	for _, paragraph := range paragraphs {
+		body = append(body, strings.Join(paragraph, "\n"))
+	}
+
+	commit.Body = strings.Join(body, "\n\n")
+
+	for _, footer := range commit.Footers {
+		if Breaking(footer.Token) {
+			commit.Breaking = true
+		}
+	}
+
+	return commit, nil
+}
+
+// footers parses a footer paragraph; lines not matching [Trailer] continue the preceding footer's value.
+func footers(lines []string) []Footer {
+	var collection []Footer
+
+	for _, line := range lines {
+		if match := Trailer.FindStringSubmatch(line); match != nil {
+			value := match[3]
+			if match[2] == " #" {
+				value = "#" + value
+			}
+
+			collection = append(collection, Footer{Token: match[1], Value: value})
+
+			continue
+		}
+
+		if len(collection) > 0 {
+			collection[len(collection)-1].Value += "\n" + line
+		}
+	}
+
+	return collection
+}
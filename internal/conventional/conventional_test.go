@@ -0,0 +1,123 @@
+package conventional
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected Commit
+	}{
+		{
+			name:     "header",
+			message:  "feat: add a command",
+			expected: Commit{Type: "feat", Description: "add a command"},
+		},
+		{
+			name:     "scope",
+			message:  "fix(parser): handle empty input",
+			expected: Commit{Type: "fix", Scope: "parser", Description: "handle empty input"},
+		},
+		{
+			name:     "type-case",
+			message:  "Feat: add a command",
+			expected: Commit{Type: "feat", Description: "add a command"},
+		},
+		{
+			name:     "breaking-marker",
+			message:  "feat(api)!: remove the legacy endpoint",
+			expected: Commit{Type: "feat", Scope: "api", Breaking: true, Description: "remove the legacy endpoint"},
+		},
+		{
+			name:    "body",
+			message: "fix: handle empty input\n\nThe first paragraph.\n\nThe second\nparagraph.",
+			expected: Commit{
+				Type:        "fix",
+				Description: "handle empty input",
+				Body:        "The first paragraph.\n\nThe second\nparagraph.",
+			},
+		},
+		{
+			name:    "footers",
+			message: "fix: handle empty input\n\nThe body.\n\nRefs: #123\nCloses #456\nReviewed-by: someone",
+			expected: Commit{
+				Type:        "fix",
+				Description: "handle empty input",
+				Body:        "The body.",
+				Footers:     []Footer{{Token: "Refs", Value: "#123"}, {Token: "Closes", Value: "#456"}, {Token: "Reviewed-by", Value: "someone"}},
+			},
+		},
+		{
+			name:    "breaking-footer",
+			message: "refactor: rename the configuration\n\nBREAKING CHANGE: the \"feed\" key is now \"update.feed\"\nmigrate existing files",
+			expected: Commit{
+				Type:        "refactor",
+				Breaking:    true,
+				Description: "rename the configuration",
+				Footers:     []Footer{{Token: "BREAKING CHANGE", Value: "the \"feed\" key is now \"update.feed\"\nmigrate existing files"}},
+			},
+		},
+		{
+			name:    "breaking-footer-hyphenated",
+			message: "feat!: drop support\n\nBREAKING-CHANGE: go 1.24 is no longer supported",
+			expected: Commit{
+				Type:        "feat",
+				Breaking:    true,
+				Description: "drop support",
+				Footers:     []Footer{{Token: "BREAKING-CHANGE", Value: "go 1.24 is no longer supported"}},
+			},
+		},
+		{
+			name:    "body-resembling-footer",
+			message: "docs: explain\n\nNote: this paragraph isn't last.\n\nThe final paragraph.",
+			expected: Commit{
+				Type:        "docs",
+				Description: "explain",
+				Body:        "Note: this paragraph isn't last.\n\nThe final paragraph.",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			commit, e := Parse(test.message)
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if !reflect.DeepEqual(commit, test.expected) {
+				t.Fatalf("expected %#v, received %#v", test.expected, commit)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, message := range []string{"", "add a command", "feat add a command", "feat:add a command", "feat(scope: add a command"} {
+		if commit, e := Parse(message); e == nil {
+			t.Errorf("expected %q to be rejected; received %#v", message, commit)
+		}
+	}
+}
+
+func TestNotes(t *testing.T) {
+	commit, e := Parse("feat!: change\n\nBREAKING CHANGE: first\nRefs: #1\nBREAKING-CHANGE: second")
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	if notes := commit.Notes(); !reflect.DeepEqual(notes, []string{"first", "second"}) {
+		t.Fatalf("unexpected notes: %q", notes)
+	}
+}
+
+func TestClean(t *testing.T) {
+	message := "feat: add a command  \n# Please enter the commit message.\n\nThe body.\t\n\n# ------------------------ >8 ------------------------\ndiff --git a/file b/file\n"
+
+	if cleaned := Clean(message); cleaned != "feat: add a command\n\nThe body." {
+		t.Fatalf("unexpected message: %q", cleaned)
+	}
+}
@@ -0,0 +1,7 @@
+// Package conventional parses [Conventional Commits] messages -- the "<type>(<scope>)!: <description>" header,
+// the optional body and the git-trailer style footers, including "BREAKING CHANGE" footers.
+//
+// The recognized types mirror the repository's ".gitmessage" template.
+//
+// [Conventional Commits]: https://www.conventionalcommits.org/en/v1.0.0/
+package conventional
@@ -0,0 +1,205 @@
+package conventional
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Severity represents a [Diagnostic]'s severity.
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+)
+
+// Limit is the maximum length of a commit message's header.
+const Limit = 100
+
+// Diagnostic represents a single lint finding. Line and Column are 1-based positions within the [Clean] message.
+type Diagnostic struct {
+	Line     int      `json:"line" yaml:"line"`
+	Column   int      `json:"column" yaml:"column"`
+	Severity Severity `json:"severity" yaml:"severity"`
+	Rule     string   `json:"rule" yaml:"rule"`
+	Message  string   `json:"message" yaml:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s [%s]", d.Line, d.Column, d.Severity, d.Message, d.Rule)
+}
+
+var (
+	// generated matches git-generated headers that aren't subject to linting.
+	generated = regexp.MustCompile(`^(Merge |Revert "|fixup! |squash! |amend! )`)
+
+	// loose matches a malformed breaking-change footer token (e.g. "Breaking change:", "BREAKING CHANGES:").
+	loose = regexp.MustCompile(`(?i)^breaking[ _-]?changes?\s*:`)
+
+	// prefix matches a header's type and optional scope, without regard for the remaining syntax.
+	prefix = regexp.MustCompile(`^([^(!:\s]*)(\(([^)]*)\)?)?`)
+)
+
+// Lint validates a commit message (as produced by [Clean]) against the Conventional Commits specification and
+// the repository's ".gitmessage" conventions.
+func Lint(message string) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	report := func(line, column int, severity Severity, rule, format string, a ...any) {
+		diagnostics = append(diagnostics, Diagnostic{Line: line, Column: column, Severity: severity, Rule: rule, Message: fmt.Sprintf(format, a...)})
+	}
+
+	if strings.TrimSpace(message) == "" {
+		report(1, 1, Error, "message-empty", "commit message is empty")
+
+		return diagnostics
+	}
+
+	lines := strings.Split(message, "\n")
+	header := lines[0]
+
+	if generated.MatchString(header) {
+		return nil
+	}
+
+	diagnostics = append(diagnostics, headline(header)...)
+
+	if length := utf8.RuneCountInString(header); length > Limit {
+		report(1, Limit+1, Error, "header-max-length", "header is %d characters long; the maximum is %d", length, Limit)
+	}
+
+	if len(lines) > 1 && strings.TrimSpace(lines[1]) != "" {
+		report(2, 1, Error, "body-leading-blank", "the body must be separated from the header by a blank line")
+	}
+
+	// Validate footers: every breaking-change footer must be correctly spelled, non-empty, and begin a footer
+	// paragraph (i.e. follow a blank line or another footer).
+	var footer bool
+	for index := 1; index < len(lines); index++ {
+		line := lines[index]
+		number := index + 1
+
+		if strings.TrimSpace(line) == "" {
+			footer = false
+
+			continue
+		}
+
+		match := Trailer.FindStringSubmatch(line)
+
+		if match == nil && Breaking(strings.TrimSuffix(line, ":")) {
+			report(number, len(line)+1, Error, "breaking-change-empty", "breaking-change footer requires a description")
+		} else if match == nil && loose.MatchString(line) {
+			report(number, 1, Error, "breaking-change-format", "breaking-change footers must be spelled \"BREAKING CHANGE: <description>\"")
+		}
+
+		if match != nil && Breaking(match[1]) {
+			if match[2] != ": " {
+				report(number, len(match[1])+1, Error, "breaking-change-format", "%q must be followed by \": \"", match[1])
+			} else if strings.TrimSpace(match[3]) == "" {
+				report(number, len(match[0])+1, Error, "breaking-change-empty", "breaking-change footer requires a description")
+			}
+
+			if !footer && index > 1 && strings.TrimSpace(lines[index-1]) != "" {
+				report(number, 1, Error, "footer-leading-blank", "footers must be separated from the body by a blank line")
+			}
+		}
+
+		// A footer paragraph begins with a trailer that follows a blank line; continuation lines remain within it.
+		if match != nil && (footer || strings.TrimSpace(lines[index-1]) == "") {
+			footer = true
+		}
+	}
+
+	return diagnostics
+}
+
+// headline validates the header's type, scope, breaking marker and description.
+func headline(header string) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	report := func(column int, severity Severity, rule, format string, a ...any) {
+		diagnostics = append(diagnostics, Diagnostic{Line: 1, Column: column, Severity: severity, Rule: rule, Message: fmt.Sprintf(format, a...)})
+	}
+
+	parts := prefix.FindStringSubmatch(header)
+	kind := parts[1]
+
+	switch {
+	case kind == "":
+		report(1, Error, "type-empty", "header must begin with a type (e.g. \"feat: <description>\")")
+	case kind != strings.ToLower(kind):
+		report(1, Error, "type-case", "type %q must be lower-case", kind)
+	}
+
+	if _, valid := Types[strings.ToLower(kind)]; kind != "" && !valid {
+		report(1, Error, "type-enum", "unknown type %q; expected one of: %s", kind, strings.Join(Names(), ", "))
+	}
+
+	column := len(kind) + 1
+
+	if parts[2] != "" {
+		scope := parts[3]
+
+		switch {
+		case !strings.HasSuffix(parts[2], ")"):
+			report(column, Error, "scope-format", "scope is missing its closing parenthesis")
+
+			return diagnostics
+		case strings.TrimSpace(scope) == "":
+			report(column, Error, "scope-empty", "scope must not be empty; omit the parentheses instead")
+		case strings.ContainsFunc(scope, unicode.IsSpace):
+			report(column+1, Error, "scope-format", "scope %q must not contain whitespace", scope)
+		}
+
+		column += len(parts[2])
+	}
+
+	rest := header[column-1:]
+	if strings.HasPrefix(rest, "!") {
+		rest = rest[1:]
+		column++
+	}
+
+	if !strings.HasPrefix(rest, ":") {
+		report(column, Error, "header-format", "expected \":\" after the type%s", map[bool]string{true: " and scope", false: ""}[parts[2] != ""])
+
+		return diagnostics
+	}
+
+	description := strings.TrimLeft(rest[1:], " ")
+
+	if description == "" {
+		report(column+1, Error, "description-empty", "description must not be empty")
+
+		return diagnostics
+	}
+
+	if !strings.HasPrefix(rest, ": ") {
+		report(column+1, Error, "header-format", "expected a space after \":\"")
+	}
+
+	column += len(rest) - len(description)
+
+	if strings.HasPrefix(rest, ":  ") {
+		report(column-1, Warning, "description-format", "description must be preceded by a single space")
+	}
+
+	if first, _ := utf8.DecodeRuneInString(description); unicode.IsUpper(first) {
+		report(column, Warning, "description-case", "description should begin with a lower-case letter")
+	}
+
+	if strings.HasSuffix(description, ".") {
+		report(column+len(description)-1, Warning, "description-full-stop", "description should not end with a period")
+	}
+
+	return diagnostics
+}
+
+// Names returns the recognized commit types in the order listed by ".gitmessage".
+func Names() []string {
+	return []string{"build", "ci", "docs", "feat", "fix", "perf", "refactor", "style", "test", "bump", "chore", "revert"}
+}
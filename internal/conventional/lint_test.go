@@ -0,0 +1,91 @@
+package conventional
+
+import (
+	"strings"
+	"testing"
+)
+
+// rules returns the diagnostics' string representations, for failure messages.
+func rules(diagnostics []Diagnostic) []string {
+	var collection []string
+	for _, diagnostic := range diagnostics {
+		collection = append(collection, diagnostic.String())
+	}
+
+	return collection
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		rule     string   // rule is the expected diagnostic's rule; empty for a valid message.
+		severity Severity // severity is the expected diagnostic's severity.
+		line     int
+		column   int
+	}{
+		// Valid messages.
+		{name: "valid", message: "feat: add a command"},
+		{name: "valid-scope", message: "fix(parser)!: handle empty input"},
+		{name: "valid-body", message: "fix: handle empty input\n\nThe body.\n\nBREAKING CHANGE: the input is required\nRefs: #123"},
+		{name: "valid-maximum-length", message: "feat: " + strings.Repeat("a", Limit-len("feat: "))},
+		{name: "generated-merge", message: "Merge branch 'main' into feature"},
+		{name: "generated-fixup", message: "fixup! feat: add a command"},
+
+		{name: "message-empty", message: " \n", rule: "message-empty", severity: Error, line: 1, column: 1},
+
+		// Header length.
+		{name: "header-max-length", message: "feat: " + strings.Repeat("a", Limit-len("feat: ")+1), rule: "header-max-length", severity: Error, line: 1, column: Limit + 1},
+		{name: "header-max-length-runes", message: "feat: " + strings.Repeat("é", Limit-len("feat: ")+1), rule: "header-max-length", severity: Error, line: 1, column: Limit + 1},
+
+		// Type allow-list.
+		{name: "type-empty", message: ": add a command", rule: "type-empty", severity: Error, line: 1, column: 1},
+		{name: "type-case", message: "Feat: add a command", rule: "type-case", severity: Error, line: 1, column: 1},
+		{name: "type-enum", message: "feature: add a command", rule: "type-enum", severity: Error, line: 1, column: 1},
+
+		// Scope.
+		{name: "scope-empty", message: "feat(): add a command", rule: "scope-empty", severity: Error, line: 1, column: 5},
+		{name: "scope-whitespace", message: "feat(the cli): add a command", rule: "scope-format", severity: Error, line: 1, column: 6},
+		{name: "scope-unclosed", message: "feat(cli: add a command", rule: "scope-format", severity: Error, line: 1, column: 5},
+
+		// Header syntax and description.
+		{name: "header-colon", message: "feat add a command", rule: "header-format", severity: Error, line: 1, column: 5},
+		{name: "header-space", message: "feat:add a command", rule: "header-format", severity: Error, line: 1, column: 6},
+		{name: "description-empty", message: "feat: ", rule: "description-empty", severity: Error, line: 1, column: 6},
+		{name: "description-spaces", message: "feat:  add a command", rule: "description-format", severity: Warning, line: 1, column: 7},
+		{name: "description-case", message: "feat: Add a command", rule: "description-case", severity: Warning, line: 1, column: 7},
+		{name: "description-full-stop", message: "feat: add a command.", rule: "description-full-stop", severity: Warning, line: 1, column: 20},
+
+		// Blank line before the body.
+		{name: "body-leading-blank", message: "feat: add a command\nThe body.", rule: "body-leading-blank", severity: Error, line: 2, column: 1},
+
+		// Footers.
+		{name: "footer-leading-blank", message: "feat: add a command\n\nThe body.\nBREAKING CHANGE: keys moved", rule: "footer-leading-blank", severity: Error, line: 4, column: 1},
+		{name: "breaking-change-empty", message: "feat: add a command\n\nBREAKING CHANGE:", rule: "breaking-change-empty", severity: Error, line: 3, column: 17},
+		{name: "breaking-change-separator", message: "feat: add a command\n\nBREAKING CHANGE #123", rule: "breaking-change-format", severity: Error, line: 3, column: 16},
+		{name: "breaking-change-spelling", message: "feat: add a command\n\nBreaking changes: keys moved", rule: "breaking-change-format", severity: Error, line: 3, column: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diagnostics := Lint(test.message)
+
+			if test.rule == "" {
+				if len(diagnostics) > 0 {
+					t.Fatalf("expected no diagnostics, received %q", rules(diagnostics))
+				}
+
+				return
+			}
+
+			if len(diagnostics) != 1 {
+				t.Fatalf("expected a single %q diagnostic, received %q", test.rule, rules(diagnostics))
+			}
+
+			diagnostic := diagnostics[0]
+			if diagnostic.Rule != test.rule || diagnostic.Severity != test.severity || diagnostic.Line != test.line || diagnostic.Column != test.column {
+				t.Fatalf("expected %d:%d: %s [%s], received %s", test.line, test.column, test.severity, test.rule, diagnostic)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+// Package crash generates crash report bundles for recovered panics. Reports include the stack trace, build
+// metadata, platform, redacted command-line arguments and the most recent (redacted) log records, and are written to the
+// cli's cache directory (see [paths.Cache]).
+package crash
@@ -0,0 +1,79 @@
+package crash
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/paths"
+	"template-go-cli/internal/redact"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/types/output"
+)
+
+// Report represents a crash report bundle.
+type Report struct {
+	Time    string   `json:"time" yaml:"time"`
+	Panic   string   `json:"panic" yaml:"panic"`
+	Version string   `json:"version" yaml:"version"`
+	Commit  string   `json:"commit" yaml:"commit"`
+	Date    string   `json:"date" yaml:"date"`
+	Runtime string   `json:"runtime" yaml:"runtime"`
+	OS      string   `json:"os" yaml:"os"`
+	Arch    string   `json:"arch" yaml:"arch"`
+	Args    []string `json:"args" yaml:"args"`
+	Logs    []string `json:"logs" yaml:"logs"`
+	Stack   string   `json:"stack" yaml:"stack"`
+}
+
+// New creates a [Report] for the recovered panic value, timestamped by the context's clock (see [system.Get]). The
+// arguments are redacted via [redact.Args]; the logs are expected to be redacted as they're recorded (see
+// [logging.Ring]).
+//
+// [logging.Ring]: template-go-cli/internal/logging.Ring
+func New(ctx context.Context, recovered any, stack []byte, args []string, logs []string) *Report {
+	return &Report{
+		Time:    system.Get(ctx).Now().UTC().Format(time.RFC3339),
+		Panic:   fmt.Sprintf("%v", recovered),
+		Version: build.Version,
+		Commit:  build.Commit,
+		Date:    build.Date,
+		Runtime: runtime.Version(),
+		OS:      runtime.GOOS,
+		Arch:    runtime.GOARCH,
+		Args:    redact.Args(args),
+		Logs:    logs,
+		Stack:   string(stack),
+	}
+}
+
+// Write serializes the report as yaml, via the context's filesystem, into a "crashes" directory relative to
+// [paths.Cache], returning the report's file path.
+func (r *Report) Write(ctx context.Context) (string, error) {
+	directory, e := paths.Cache(ctx)
+	if e != nil {
+		return "", e
+	}
+
+	var s = system.Get(ctx)
+
+	directory = filepath.Join(directory, "crashes")
+	if e := s.Filesystem.MkdirAll(directory, 0o700); e != nil {
+		return "", fmt.Errorf("unable to create crash report directory: %w", e)
+	}
+
+	buffer, e := output.Write(output.YAML, r)
+	if e != nil {
+		return "", e
+	}
+
+	path := filepath.Join(directory, fmt.Sprintf("crash-%s.yaml", s.Now().UTC().Format("20060102T150405.000000000Z")))
+	if e := s.Filesystem.WriteFile(path, buffer.Bytes(), 0o600); e != nil {
+		return "", fmt.Errorf("unable to write crash report: %w", e)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,75 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+// Status represents a check's outcome.
+type Status string
+
+const (
+	Pass Status = "pass"
+	Warn Status = "warn"
+	Fail Status = "fail"
+)
+
+// Result represents the outcome of a single [Check].
+type Result struct {
+	Name    string `json:"name" yaml:"name"`
+	Status  Status `json:"status" yaml:"status"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// Check represents a named environment diagnostic. Run receives the root command so that checks can inspect
+// the command tree (e.g. for plugin conflicts).
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, root *cobra.Command) (Status, string)
+}
+
+var (
+	mutex  sync.Mutex
+	checks []Check
+)
+
+// Register adds a check. Checks run in registration order.
+func Register(check Check) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	checks = append(checks, check)
+}
+
+// Run executes every registered check, recovering from panicking checks as failures.
+func Run(ctx context.Context, root *cobra.Command) []Result {
+	mutex.Lock()
+	var registered = make([]Check, len(checks))
+	copy(registered, checks)
+	mutex.Unlock()
+
+	var results = make([]Result, 0, len(registered))
+	for _, check := range registered {
+		results = append(results, run(ctx, root, check))
+	}
+
+	return results
+}
+
+// run executes a single check.
+func run(ctx context.Context, root *cobra.Command, check Check) (result Result) {
+	result.Name = check.Name
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result.Status, result.Message = Fail, fmt.Sprintf("check panicked: %v", recovered)
+		}
+	}()
+
+	result.Status, result.Message = check.Run(ctx, root)
+
+	return result
+}
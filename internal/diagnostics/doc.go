@@ -0,0 +1,3 @@
+// Package diagnostics provides a pluggable registry of environment checks, as run by the "doctor" command.
+// Packages contribute checks via [Register], typically from an init function.
+package diagnostics
@@ -0,0 +1,157 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// context is the number of unchanged lines surrounding each hunk.
+const context = 3
+
+// operation represents a single line-level edit.
+type operation struct {
+	kind byte // kind is one of ' ', '-' or '+'.
+	line string
+	a, b int // a and b are the (zero-based) line indices in the before and after texts.
+}
+
+// Unified returns the unified diff between before and after, labeled with the given names. An empty string is
+// returned when the texts are identical.
+func Unified(name string, before, after string) string {
+	if before == after {
+		return ""
+	}
+
+	a, b := lines(before), lines(after)
+	operations := edits(a, b)
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "--- a/%s\n+++ b/%s\n", name, name)
+
+	for start := 0; start < len(operations); {
+		// Locate the next change.
+		for start < len(operations) && operations[start].kind == ' ' {
+			start++
+		}
+
+		if start == len(operations) {
+			break
+		}
+
+		// Extend the hunk until a run of unchanged lines exceeds twice the context.
+		end := start
+		for index := start; index < len(operations); index++ {
+			if operations[index].kind != ' ' {
+				end = index
+			} else if index-end > 2*context {
+				break
+			}
+		}
+
+		low, high := max(start-context, 0), min(end+context+1, len(operations))
+
+		hunk(&builder, operations[low:high])
+
+		start = high
+	}
+
+	return builder.String()
+}
+
+// hunk writes a single hunk, including its header.
+func hunk(builder *strings.Builder, operations []operation) {
+	var aStart, bStart, aCount, bCount = -1, -1, 0, 0
+
+	for _, op := range operations {
+		if op.kind != '+' {
+			aCount++
+			if aStart < 0 {
+				aStart = op.a
+			}
+		}
+
+		if op.kind != '-' {
+			bCount++
+			if bStart < 0 {
+				bStart = op.b
+			}
+		}
+	}
+
+	// Empty ranges reference the line preceding the position (see GNU diff's unified format).
+	fmt.Fprintf(builder, "@@ -%s +%s @@\n", span(aStart, aCount, operations[0].a), span(bStart, bCount, operations[0].b))
+
+	for _, op := range operations {
+		fmt.Fprintf(builder, "%c%s\n", op.kind, op.line)
+	}
+}
+
+// span formats a hunk range.
+func span(start, count, fallback int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", fallback)
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// edits computes the line edit script via the longest common subsequence.
+func edits(a, b []string) []operation {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var operations []operation
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			operations = append(operations, operation{kind: ' ', line: a[i], a: i, b: j})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			operations = append(operations, operation{kind: '-', line: a[i], a: i, b: j})
+			i++
+		default:
+			operations = append(operations, operation{kind: '+', line: b[j], a: i, b: j})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		operations = append(operations, operation{kind: '-', line: a[i], a: i, b: j})
+	}
+
+	for ; j < len(b); j++ {
+		operations = append(operations, operation{kind: '+', line: b[j], a: i, b: j})
+	}
+
+	return operations
+}
+
+// lines splits text into lines, without their line terminators.
+func lines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
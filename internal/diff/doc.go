@@ -0,0 +1,2 @@
+// Package diff renders line-based unified diffs.
+package diff
@@ -0,0 +1,7 @@
+// Package exceptions provides the cli's typed error hierarchy. Every [Exception] carries a [Kind] that maps to a
+// distinct process exit code, an optional hint, and structured details suitable for machine-readable output.
+//
+// Commands return exceptions from their RunE function(s); [From] classifies any other error, and [Report] renders
+// an exception as either human-readable text or -- when an output format was explicitly requested -- as a
+// structured object via [output.Write].
+package exceptions
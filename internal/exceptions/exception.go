@@ -0,0 +1,111 @@
+package exceptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Exception is the cli's structured error type.
+type Exception struct {
+	Kind    Kind           // Kind classifies the exception.
+	Message string         // Message is a human-readable summary; the cause's message is appended by [Exception.Error].
+	Hint    string         // Hint optionally suggests a remediation.
+	Details map[string]any // Details are arbitrary, structured key-value pairs included in machine-readable output.
+	Cause   error          // Cause is the optional, wrapped error.
+
+	code int // code optionally overrides the kind's exit code; see [Exception.WithCode].
+}
+
+// New creates an exception of the given kind with a formatted message.
+func New(kind Kind, format string, a ...any) *Exception {
+	return &Exception{Kind: kind, Message: fmt.Sprintf(format, a...)}
+}
+
+// Wrap creates an exception of the given kind that wraps cause. An empty format uses the cause's message alone.
+func Wrap(kind Kind, cause error, format string, a ...any) *Exception {
+	return &Exception{Kind: kind, Message: fmt.Sprintf(format, a...), Cause: cause}
+}
+
+// Error implements the error interface.
+func (e *Exception) Error() string {
+	switch {
+	case e.Cause == nil:
+		return e.Message
+	case e.Message == "":
+		return e.Cause.Error()
+	default:
+		return fmt.Sprintf("%s: %s", e.Message, e.Cause)
+	}
+}
+
+// Unwrap returns the exception's cause.
+func (e *Exception) Unwrap() error {
+	return e.Cause
+}
+
+// Code returns the exception's process exit code.
+func (e *Exception) Code() int {
+	if e.code != 0 {
+		return e.code
+	}
+
+	return e.Kind.Code()
+}
+
+// WithHint sets a formatted remediation hint and returns the exception.
+func (e *Exception) WithHint(format string, a ...any) *Exception {
+	e.Hint = fmt.Sprintf(format, a...)
+
+	return e
+}
+
+// WithDetail adds a structured key-value detail and returns the exception.
+func (e *Exception) WithDetail(key string, value any) *Exception {
+	if e.Details == nil {
+		e.Details = map[string]any{}
+	}
+
+	e.Details[key] = value
+
+	return e
+}
+
+// WithCode overrides the kind's exit code (e.g. to propagate a child process' exit status) and returns the exception.
+func (e *Exception) WithCode(code int) *Exception {
+	e.code = code
+
+	return e
+}
+
+// From converts any error into an [Exception]. Exceptions anywhere in the error's chain are returned as-is;
+// context cancellation maps to [Cancelled], exceeded deadlines to [Timeout], and everything else to [Internal].
+func From(err error) *Exception {
+	if err == nil {
+		return nil
+	}
+
+	var exception *Exception
+	if errors.As(err, &exception) {
+		return exception
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Wrap(Timeout, err, "")
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return Wrap(Cancelled, err, "")
+	}
+
+	return Wrap(Internal, err, "")
+}
+
+// Code returns the exit code for any error; nil errors return 0.
+func Code(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	return From(err).Code()
+}
@@ -0,0 +1,42 @@
+package exceptions
+
+// Kind classifies an [Exception] and determines its default exit code.
+type Kind string
+
+const (
+	Internal   Kind = "internal"   // Internal represents an unexpected runtime failure.
+	Usage      Kind = "usage"      // Usage represents invalid command-line usage (unknown commands, flags or arguments).
+	Validation Kind = "validation" // Validation represents well-formed input that failed semantic validation.
+	NotFound   Kind = "not-found"  // NotFound represents a missing resource (file, plugin, release, etc.).
+	Conflict   Kind = "conflict"   // Conflict represents a resource state conflict (e.g. a dirty working tree).
+	Cancelled  Kind = "cancelled"  // Cancelled represents an interrupted command.
+	Timeout    Kind = "timeout"    // Timeout represents a command that exceeded its deadline (e.g. the "--timeout").
+)
+
+// Code returns the process exit code associated with the kind.
+//
+//   - Internal: 1
+//   - Usage: 2
+//   - Validation: 3
+//   - NotFound: 4
+//   - Conflict: 5
+//   - Cancelled: 130 (conventional 128 + SIGINT)
+//   - Timeout: 124 (as timeout(1))
+func (k Kind) Code() int {
+	switch k {
+	case Usage:
+		return 2
+	case Validation:
+		return 3
+	case NotFound:
+		return 4
+	case Conflict:
+		return 5
+	case Cancelled:
+		return 130
+	case Timeout:
+		return 124
+	default:
+		return 1
+	}
+}
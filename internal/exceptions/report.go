@@ -0,0 +1,54 @@
+package exceptions
+
+import (
+	"fmt"
+	"io"
+
+	"template-go-cli/internal/types/output"
+)
+
+// report is the machine-readable representation of an [Exception].
+type report struct {
+	Error struct {
+		Kind    Kind           `json:"kind" yaml:"kind"`
+		Code    int            `json:"code" yaml:"code"`
+		Message string         `json:"message" yaml:"message"`
+		Hint    string         `json:"hint,omitempty" yaml:"hint,omitempty"`
+		Details map[string]any `json:"details,omitempty" yaml:"details,omitempty"`
+	} `json:"error" yaml:"error"`
+}
+
+// Report writes the exception to w. When format is non-nil, the exception is serialized via [output.Write];
+// otherwise, a human-readable message (and hint, if any) is written.
+func Report(w io.Writer, format *output.Type, exception *Exception) error {
+	if format == nil {
+		if _, e := fmt.Fprintf(w, "Error: %s\n", exception.Error()); e != nil {
+			return e
+		}
+
+		if exception.Hint != "" {
+			if _, e := fmt.Fprintf(w, "Hint: %s\n", exception.Hint); e != nil {
+				return e
+			}
+		}
+
+		return nil
+	}
+
+	var datum report
+
+	datum.Error.Kind = exception.Kind
+	datum.Error.Code = exception.Code()
+	datum.Error.Message = exception.Error()
+	datum.Error.Hint = exception.Hint
+	datum.Error.Details = exception.Details
+
+	buffer, e := output.Write(*format, datum)
+	if e != nil {
+		return e
+	}
+
+	_, e = w.Write(buffer.Bytes())
+
+	return e
+}
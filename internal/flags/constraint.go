@@ -0,0 +1,39 @@
+package flags
+
+import (
+	"template-go-cli/internal/semver"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Constraint is a [pflag.Value] for semantic version constraints (e.g. ">=1.2.0, <2.0.0", "^1.4"); see
+// [semver.Constraint].
+type Constraint struct {
+	semver.Constraint
+}
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Constraint)(nil)
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (c *Constraint) Set(v string) error {
+	constraint, e := semver.ParseConstraint(v)
+	if e != nil {
+		return e
+	}
+
+	c.Constraint = constraint
+
+	return nil
+}
+
+// Type is only used in help text.
+func (c *Constraint) Type() string {
+	return "constraint"
+}
+
+// Completion is a [cobra.CompletionFunc] suggesting constraint operators.
+func (c *Constraint) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return []cobra.Completion{"^", "~", ">=", "<", "="}, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
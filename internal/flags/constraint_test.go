@@ -0,0 +1,64 @@
+package flags
+
+import (
+	"testing"
+
+	"template-go-cli/internal/semver"
+)
+
+func TestConstraint(t *testing.T) {
+	tests := []struct {
+		constraint string
+		matches    []string
+		rejects    []string
+	}{
+		{constraint: "1.2.3", matches: []string{"1.2.3", "v1.2.3+build"}, rejects: []string{"1.2.4", "1.2.3-rc.1"}},
+		{constraint: ">=1.2.0, <2.0.0", matches: []string{"1.2.0", "1.9.9"}, rejects: []string{"1.1.9", "2.0.0", "2.0.0-rc.1"}},
+		{constraint: ">= 1.2.0 < 2", matches: []string{"1.5.0"}, rejects: []string{"2.0.0"}},
+		{constraint: "^1.2.3", matches: []string{"1.2.3", "1.9.0"}, rejects: []string{"1.2.2", "2.0.0", "1.3.0-beta"}},
+		{constraint: "^0.2.3", matches: []string{"0.2.3", "0.2.9"}, rejects: []string{"0.3.0"}},
+		{constraint: "^0.0.3", matches: []string{"0.0.3"}, rejects: []string{"0.0.4"}},
+		{constraint: "~1.2.3", matches: []string{"1.2.3", "1.2.9"}, rejects: []string{"1.3.0"}},
+		{constraint: "~1", matches: []string{"1.0.0", "1.9.0"}, rejects: []string{"2.0.0"}},
+		{constraint: "1.2.x", matches: []string{"1.2.0", "1.2.7"}, rejects: []string{"1.3.0"}},
+		{constraint: "*", matches: []string{"0.0.0", "9.9.9"}, rejects: []string{"1.0.0-rc.1"}},
+		{constraint: ">1.2", matches: []string{"1.3.0"}, rejects: []string{"1.2.9"}},
+		{constraint: "<=1.2", matches: []string{"1.2.9"}, rejects: []string{"1.3.0"}},
+		{constraint: "!=1.2.3", matches: []string{"1.2.4"}, rejects: []string{"1.2.3"}},
+		{constraint: "^1.0 || ^3.1", matches: []string{"1.4.0", "3.2.0"}, rejects: []string{"2.0.0", "3.0.0"}},
+		{constraint: ">=1.2.3-rc.1, <1.2.4", matches: []string{"1.2.3-rc.2", "1.2.3"}, rejects: []string{"1.2.4-rc.1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.constraint, func(t *testing.T) {
+			var constraint Constraint
+
+			if e := constraint.Set(test.constraint); e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if constraint.String() != test.constraint {
+				t.Errorf("expected string %q, received %q", test.constraint, constraint.String())
+			}
+
+			for _, version := range test.matches {
+				if !constraint.Check(semver.MustParse(version)) {
+					t.Errorf("expected %q to satisfy %q", version, test.constraint)
+				}
+			}
+
+			for _, version := range test.rejects {
+				if constraint.Check(semver.MustParse(version)) {
+					t.Errorf("expected %q not to satisfy %q", version, test.constraint)
+				}
+			}
+		})
+	}
+
+	for _, input := range []string{"", ">=", "1.2.3.4", "^abc", "1.2 ||", "!=1.2", "1.2-rc.1"} {
+		var constraint Constraint
+		if e := constraint.Set(input); e == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
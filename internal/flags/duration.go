@@ -0,0 +1,156 @@
+package flags
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Duration is a [pflag.Value] for human durations: [time.ParseDuration] syntax extended with day ("d") and week
+// ("w") units (e.g. "1d2h", "1w", "90m", "1.5d").
+type Duration time.Duration
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Duration)(nil)
+
+// Day and Week are the extended units' durations; a day is always 24 hours.
+const (
+	Day  = 24 * time.Hour
+	Week = 7 * Day
+)
+
+// ParseDuration parses a human duration; see [Duration].
+func ParseDuration(v string) (time.Duration, error) {
+	value := strings.TrimSpace(v)
+	if value == "" {
+		return 0, fmt.Errorf("invalid duration %q", v)
+	}
+
+	sign := time.Duration(1)
+	if value[0] == '-' || value[0] == '+' {
+		if value[0] == '-' {
+			sign = -1
+		}
+
+		value = value[1:]
+	}
+
+	var total time.Duration
+	for value != "" {
+		index := strings.IndexFunc(value, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.'
+		})
+
+		if index <= 0 {
+			if value == "0" {
+				return 0, nil
+			}
+
+			return 0, fmt.Errorf("invalid duration %q: missing unit", v)
+		}
+
+		end := index + strings.IndexFunc(value[index:], func(r rune) bool {
+			return (r >= '0' && r <= '9') || r == '.'
+		})
+
+		if end < index {
+			end = len(value)
+		}
+
+		number, unit := value[:index], value[index:end]
+		value = value[end:]
+
+		var multiplier time.Duration
+		switch unit {
+		case "w":
+			multiplier = Week
+		case "d":
+			multiplier = Day
+		default:
+			component, e := time.ParseDuration(number + unit)
+			if e != nil {
+				return 0, fmt.Errorf("invalid duration %q: %w", v, e)
+			}
+
+			total += component
+
+			continue
+		}
+
+		quantity, e := strconv.ParseFloat(number, 64)
+		if e != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", v, e)
+		}
+
+		total += time.Duration(quantity * float64(multiplier))
+	}
+
+	return sign * total, nil
+}
+
+// FormatDuration renders d using the largest applicable units, including days (e.g. "1d2h30m"). Weeks aren't
+// used, as they're ambiguous when read back alongside days.
+func FormatDuration(d time.Duration) string {
+	if d < Day && d > -Day {
+		return d.String()
+	}
+
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	days := d / Day
+	remainder := d % Day
+
+	if remainder == 0 {
+		return fmt.Sprintf("%s%dd", sign, days)
+	}
+
+	// Omit zero-valued hour and minute components (e.g. "2h0m0s" renders as "2h").
+	var rest string
+	if hours := remainder / time.Hour; hours > 0 {
+		rest += fmt.Sprintf("%dh", hours)
+	}
+
+	if minutes := remainder % time.Hour / time.Minute; minutes > 0 {
+		rest += fmt.Sprintf("%dm", minutes)
+	}
+
+	if seconds := remainder % time.Minute; seconds > 0 {
+		rest += seconds.String()
+	}
+
+	return fmt.Sprintf("%s%dd%s", sign, days, rest)
+}
+
+// String is used both by fmt.Print and by Cobra in help text.
+func (d *Duration) String() string {
+	return FormatDuration(time.Duration(*d))
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (d *Duration) Set(v string) error {
+	duration, e := ParseDuration(v)
+	if e != nil {
+		return e
+	}
+
+	*d = Duration(duration)
+
+	return nil
+}
+
+// Type is only used in help text.
+func (d *Duration) Type() string {
+	return "duration"
+}
+
+// Completion is a [cobra.CompletionFunc] suggesting common durations.
+func (d *Duration) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return []cobra.Completion{"30s", "5m", "1h", "1d", "1w"}, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,58 @@
+package flags
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		text    string
+		invalid bool
+	}{
+		{input: "0", want: 0, text: "0s"},
+		{input: "90m", want: 90 * time.Minute, text: "1h30m0s"},
+		{input: "1d", want: Day, text: "1d"},
+		{input: "1d2h", want: Day + 2*time.Hour, text: "1d2h"},
+		{input: "1w", want: Week, text: "7d"},
+		{input: "1.5d", want: 36 * time.Hour, text: "1d12h"},
+		{input: "2d10m", want: 2*Day + 10*time.Minute, text: "2d10m"},
+		{input: "1d1h1m1s", want: Day + time.Hour + time.Minute + time.Second, text: "1d1h1m1s"},
+		{input: "-1d", want: -Day, text: "-1d"},
+		{input: "250ms", want: 250 * time.Millisecond, text: "250ms"},
+		{input: "", invalid: true},
+		{input: "1", invalid: true},
+		{input: "d", invalid: true},
+		{input: "1y", invalid: true},
+		{input: "1d2", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var duration Duration
+
+			e := duration.Set(test.input)
+			if test.invalid {
+				if e == nil {
+					t.Fatalf("expected error, received %s", time.Duration(duration))
+				}
+
+				return
+			}
+
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if time.Duration(duration) != test.want {
+				t.Errorf("expected %s, received %s", test.want, time.Duration(duration))
+			}
+
+			if duration.String() != test.text {
+				t.Errorf("expected string %q, received %q", test.text, duration.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Option describes a single valid value of an [Enumeration].
+type Option[T ~string] struct {
+	Value       T        // Value is the canonical value stored upon a match.
+	Description string   // Description is shown in help text and shell completion.
+	Aliases     []string // Aliases are alternative spellings that resolve to Value.
+}
+
+// Enumeration is an ordered set of valid values for a string-like type. Matching is case-insensitive against
+// both values and aliases; the canonical [Option.Value] is always what's stored.
+type Enumeration[T ~string] []Option[T]
+
+// Parse resolves v to its canonical value.
+func (e Enumeration[T]) Parse(v string) (T, error) {
+	for _, option := range e {
+		if strings.EqualFold(v, string(option.Value)) {
+			return option.Value, nil
+		}
+
+		for _, alias := range option.Aliases {
+			if strings.EqualFold(v, alias) {
+				return option.Value, nil
+			}
+		}
+	}
+
+	var quoted = make([]string, 0, len(e))
+	for _, option := range e {
+		quoted = append(quoted, fmt.Sprintf("%q", option.Value))
+	}
+
+	return "", fmt.Errorf("must be one of %s", strings.Join(quoted, ", "))
+}
+
+// Set parses v and, if valid, stores the canonical value in target.
+func (e Enumeration[T]) Set(target *T, v string) error {
+	value, exception := e.Parse(v)
+	if exception != nil {
+		return exception
+	}
+
+	*target = value
+
+	return nil
+}
+
+// Values returns the canonical values, in order.
+func (e Enumeration[T]) Values() []T {
+	var values = make([]T, 0, len(e))
+	for _, option := range e {
+		values = append(values, option.Value)
+	}
+
+	return values
+}
+
+// Type returns the help text's value placeholder (e.g. "(json|yaml)").
+func (e Enumeration[T]) Type() string {
+	var values = make([]string, 0, len(e))
+	for _, option := range e {
+		values = append(values, string(option.Value))
+	}
+
+	return "(" + strings.Join(values, "|") + ")"
+}
+
+// Describe lists each value's description (and aliases), for a command's long description; a flag's usage is kept
+// to a single line, with the values themselves listed by its [Enumeration.Type] and [Enumeration.Completion].
+func (e Enumeration[T]) Describe(flag string) string {
+	var lines = []string{fmt.Sprintf("The \"--%s\" values are:", flag)}
+	for _, option := range e {
+		line := fmt.Sprintf("  - %s: %s", option.Value, option.Description)
+		if len(option.Aliases) > 0 {
+			line += fmt.Sprintf(" (alias: %s)", strings.Join(option.Aliases, ", "))
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Completion is a [cobra.CompletionFunc] providing the canonical values, with descriptions, for shell completion.
+func (e Enumeration[T]) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	var completions = make([]cobra.Completion, 0, len(e))
+	for _, option := range e {
+		completions = append(completions, cobra.CompletionWithDesc(string(option.Value), option.Description))
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// Enum is a [pflag.Value] binding a variable to an [Enumeration], for string-like types that don't implement
+// [pflag.Value] themselves.
+type Enum[T ~string] struct {
+	Target      *T
+	Enumeration Enumeration[T]
+}
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Enum[string])(nil)
+
+// NewEnum returns an [Enum] storing into target, which is initialized to value.
+func NewEnum[T ~string](target *T, value T, enumeration Enumeration[T]) *Enum[T] {
+	*target = value
+
+	return &Enum[T]{Target: target, Enumeration: enumeration}
+}
+
+// String is used both by fmt.Print and by Cobra in help text.
+func (e *Enum[T]) String() string {
+	return string(*e.Target)
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (e *Enum[T]) Set(v string) error {
+	return e.Enumeration.Set(e.Target, v)
+}
+
+// Type is only used in help text.
+func (e *Enum[T]) Type() string {
+	return e.Enumeration.Type()
+}
@@ -0,0 +1,44 @@
+package flags
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEnumeration(t *testing.T) {
+	type color string
+
+	var enumeration = Enumeration[color]{
+		{Value: "red", Description: "the color red"},
+		{Value: "grey", Description: "the color grey", Aliases: []string{"gray"}},
+	}
+
+	var value color
+	flag := NewEnum(&value, "red", enumeration)
+
+	for input, want := range map[string]color{"RED": "red", "Grey": "grey", "GRAY": "grey"} {
+		if e := flag.Set(input); e != nil {
+			t.Fatalf("unexpected error for %q: %v", input, e)
+		}
+
+		if value != want {
+			t.Errorf("expected %q for %q, received %q", want, input, value)
+		}
+	}
+
+	if e := flag.Set("blue"); e == nil || e.Error() != `must be one of "red", "grey"` {
+		t.Errorf("unexpected error: %v", e)
+	}
+
+	if flag.Type() != "(red|grey)" {
+		t.Errorf("unexpected type %q", flag.Type())
+	}
+
+	completions, _ := enumeration.Completion(nil, nil, "")
+	if !slices.Equal(completions, []string{"red\tthe color red", "grey\tthe color grey"}) {
+		t.Errorf("unexpected completions %q", completions)
+	}
+	if description := enumeration.Describe("color"); description != "The \"--color\" values are:\n  - red: the color red\n  - grey: the color grey (alias: gray)" {
+		t.Errorf("unexpected description %q", description)
+	}
+}
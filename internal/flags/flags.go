@@ -1 +1,2 @@
+// Package flags provides reusable [pflag.Value] implementations for cli flags.
 package flags
@@ -2,21 +2,27 @@ package format
 
 import (
 	"context"
+
+	"template-go-cli/internal/contextual"
 	"template-go-cli/internal/types/output"
 )
 
-// keyer is a custom type for context keys to prevent key collisions.
-type keyer string
-
-const (
-	// key is the context key used to store and retrieve the package's context value. See [With] and [Get] for additional details.
-	key keyer = "format"
-)
+// key is the context key used to store and retrieve the output format; absent a value, the format defaults to
+// [output.JSON]. See [With] and [Get] for additional details.
+var key = contextual.New("format", func() output.Type { return output.JSON })
 
+// With returns a copy of ctx holding the output format.
 func With(ctx context.Context, v output.Type) context.Context {
-	return context.WithValue(ctx, key, v)
+	return key.With(ctx, v)
 }
 
+// Get returns the output format held by ctx, or [output.JSON] if one wasn't set (e.g. when a command's RunE is
+// called directly, without the root command's persistent pre-run).
 func Get(ctx context.Context) output.Type {
-	return ctx.Value(key).(output.Type)
+	return key.Value(ctx)
+}
+
+// Lookup returns the output format held by ctx, and whether one was set.
+func Lookup(ctx context.Context) (output.Type, bool) {
+	return key.Get(ctx)
 }
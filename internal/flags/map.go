@@ -0,0 +1,59 @@
+package flags
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Map is a repeatable [pflag.Value] accumulating "key=value" pairs (e.g. "--label a=1 --label b=2"). Each
+// occurrence accepts a single pair, so values may contain commas and "=" characters; a repeated key's last value
+// wins.
+type Map map[string]string
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Map)(nil)
+
+// String renders the pairs sorted by key (e.g. "a=1,b=2").
+func (m *Map) String() string {
+	var pairs = make([]string, 0, len(*m))
+	for _, key := range slices.Sorted(maps.Keys(*m)) {
+		pairs = append(pairs, key+"="+(*m)[key])
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (m *Map) Set(v string) error {
+	key, value, found := strings.Cut(v, "=")
+	if !found {
+		return fmt.Errorf("invalid pair %q: expected \"key=value\"", v)
+	}
+
+	if key = strings.TrimSpace(key); key == "" {
+		return fmt.Errorf("invalid pair %q: key must not be empty", v)
+	}
+
+	if *m == nil {
+		*m = Map{}
+	}
+
+	(*m)[key] = value
+
+	return nil
+}
+
+// Type is only used in help text.
+func (m *Map) Type() string {
+	return "key=value"
+}
+
+// Completion is a [cobra.CompletionFunc] that disables file completion.
+func (m *Map) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,34 @@
+package flags
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestMap(t *testing.T) {
+	var labels Map
+
+	set := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	set.Var(&labels, "label", "")
+
+	if e := set.Parse([]string{"--label", "a=1", "--label", "b=x=y,z", "--label", "a=2", "--label", "empty="}); e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	want := Map{"a": "2", "b": "x=y,z", "empty": ""}
+	if !maps.Equal(labels, want) {
+		t.Errorf("expected %v, received %v", want, labels)
+	}
+
+	if labels.String() != "a=2,b=x=y,z,empty=" {
+		t.Errorf("unexpected string %q", labels.String())
+	}
+
+	for _, input := range []string{"missing", "=value", " =value"} {
+		if e := labels.Set(input); e == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Kind represents the kind of file-system entry a [Path] must reference.
+type Kind int
+
+const (
+	Any       Kind = iota // Any accepts any path, existing or not.
+	File                  // File requires an existing, non-directory file.
+	Directory             // Directory requires an existing directory.
+)
+
+// Path is a [pflag.Value] for file-system paths. A leading "~" is expanded to the user's home directory, and the
+// path is cleaned; [File] and [Directory] kinds are validated to exist.
+type Path struct {
+	Value string
+	Kind  Kind
+}
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Path)(nil)
+
+// Expand replaces a leading "~" (or "~/") with the user's home directory, then cleans the path.
+func Expand(path string) (string, error) {
+	if path == "~" || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		home, e := os.UserHomeDir()
+		if e != nil {
+			return "", fmt.Errorf("unable to expand %q: %w", path, e)
+		}
+
+		path = filepath.Join(home, path[1:])
+	}
+
+	return filepath.Clean(path), nil
+}
+
+// String is used both by fmt.Print and by Cobra in help text.
+func (p *Path) String() string {
+	return p.Value
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (p *Path) Set(v string) error {
+	if v == "" {
+		return errors.New("path must not be empty")
+	}
+
+	path, e := Expand(v)
+	if e != nil {
+		return e
+	}
+
+	if p.Kind != Any {
+		information, e := os.Stat(path)
+		if errors.Is(e, fs.ErrNotExist) {
+			return fmt.Errorf("%q does not exist", path)
+		} else if e != nil {
+			return e
+		}
+
+		if p.Kind == File && information.IsDir() {
+			return fmt.Errorf("%q is a directory, not a file", path)
+		}
+
+		if p.Kind == Directory && !information.IsDir() {
+			return fmt.Errorf("%q is not a directory", path)
+		}
+	}
+
+	p.Value = path
+
+	return nil
+}
+
+// Type is only used in help text.
+func (p *Path) Type() string {
+	switch p.Kind {
+	case File:
+		return "file"
+	case Directory:
+		return "directory"
+	default:
+		return "path"
+	}
+}
+
+// Completion is a [cobra.CompletionFunc] deferring to the shell's file (or directory) completion.
+func (p *Path) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if p.Kind == Directory {
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	}
+
+	return nil, cobra.ShellCompDirectiveDefault
+}
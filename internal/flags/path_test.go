@@ -0,0 +1,60 @@
+package flags
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	directory := t.TempDir()
+	file := filepath.Join(directory, "file.txt")
+	if e := os.WriteFile(file, []byte("content"), 0o600); e != nil {
+		t.Fatal(e)
+	}
+
+	tests := []struct {
+		name    string
+		kind    Kind
+		input   string
+		want    string
+		invalid bool
+	}{
+		{name: "home", kind: Any, input: "~", want: home},
+		{name: "home-relative", kind: Any, input: "~/config/../file", want: filepath.Join(home, "file")},
+		{name: "non-existent", kind: Any, input: "missing/./file", want: "missing/file"},
+		{name: "tilde-user", kind: Any, input: "~user/file", want: "~user/file"},
+		{name: "file", kind: File, input: file, want: file},
+		{name: "file-missing", kind: File, input: filepath.Join(directory, "missing"), invalid: true},
+		{name: "file-directory", kind: File, input: directory, invalid: true},
+		{name: "directory", kind: Directory, input: directory + "/", want: directory},
+		{name: "directory-file", kind: Directory, input: file, invalid: true},
+		{name: "empty", kind: Any, input: "", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var path = Path{Kind: test.kind}
+
+			e := path.Set(test.input)
+			if test.invalid {
+				if e == nil {
+					t.Fatalf("expected error, received value %q", path.Value)
+				}
+
+				return
+			}
+
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if path.String() != test.want {
+				t.Errorf("expected %q, received %q", test.want, path.String())
+			}
+		})
+	}
+}
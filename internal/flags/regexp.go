@@ -0,0 +1,48 @@
+package flags
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Regexp is a [pflag.Value] for regular expressions ([regexp] syntax), compiled upon being set.
+type Regexp struct {
+	*regexp.Regexp
+}
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Regexp)(nil)
+
+// String is used both by fmt.Print and by Cobra in help text.
+func (r *Regexp) String() string {
+	if r.Regexp == nil {
+		return ""
+	}
+
+	return r.Regexp.String()
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (r *Regexp) Set(v string) error {
+	expression, e := regexp.Compile(v)
+	if e != nil {
+		return fmt.Errorf("invalid regular expression: %w", e)
+	}
+
+	r.Regexp = expression
+
+	return nil
+}
+
+// Type is only used in help text.
+func (r *Regexp) Type() string {
+	return "regexp"
+}
+
+// Completion is a [cobra.CompletionFunc] that disables file completion.
+func (r *Regexp) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
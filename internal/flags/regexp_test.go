@@ -0,0 +1,29 @@
+package flags
+
+import (
+	"testing"
+)
+
+func TestRegexp(t *testing.T) {
+	var expression Regexp
+
+	if expression.String() != "" {
+		t.Errorf("expected empty string for unset value, received %q", expression.String())
+	}
+
+	if e := expression.Set(`^v\d+$`); e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	if !expression.MatchString("v12") || expression.MatchString("12") {
+		t.Errorf("unexpected match results for %q", expression.String())
+	}
+
+	if e := expression.Set(`(unclosed`); e == nil {
+		t.Error("expected error for invalid expression")
+	}
+
+	if expression.String() != `^v\d+$` {
+		t.Errorf("expected the previous value to be retained, received %q", expression.String())
+	}
+}
@@ -0,0 +1,102 @@
+package flags
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Size is a [pflag.Value] for byte sizes with an optional decimal ("KB", "MB", ...) or binary ("KiB", "MiB", ...)
+// unit suffix (e.g. "512", "1.5GB", "10MiB"). Units are case-insensitive; "K", "M", "G", "T" and "P" are binary.
+type Size int64
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Size)(nil)
+
+// units maps each (lower-case) unit suffix to its multiplier.
+var units = map[string]float64{
+	"": 1, "b": 1,
+	"kb": 1e3, "mb": 1e6, "gb": 1e9, "tb": 1e12, "pb": 1e15,
+	"k": 1 << 10, "m": 1 << 20, "g": 1 << 30, "t": 1 << 40, "p": 1 << 50,
+	"kib": 1 << 10, "mib": 1 << 20, "gib": 1 << 30, "tib": 1 << 40, "pib": 1 << 50,
+}
+
+// ParseSize parses a byte size; see [Size].
+func ParseSize(v string) (Size, error) {
+	v = strings.TrimSpace(v)
+
+	split := strings.IndexFunc(v, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+
+	number, unit := v, ""
+	if split >= 0 {
+		number, unit = v[:split], strings.ToLower(strings.TrimSpace(v[split:]))
+	}
+
+	multiplier, valid := units[unit]
+	if !valid {
+		return 0, fmt.Errorf("invalid size %q: unknown unit %q", v, v[split:])
+	}
+
+	value, e := strconv.ParseFloat(number, 64)
+	if e != nil || number == "" {
+		return 0, fmt.Errorf("invalid size %q", v)
+	}
+
+	bytes := math.Round(value * multiplier)
+	if bytes > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q: out of range", v)
+	}
+
+	return Size(bytes), nil
+}
+
+// String renders the size in the largest binary unit that represents it exactly (e.g. "10MiB").
+func (s *Size) String() string {
+	value := int64(*s)
+
+	for _, unit := range []string{"PiB", "TiB", "GiB", "MiB", "KiB"} {
+		multiplier := int64(units[strings.ToLower(unit)])
+		if value != 0 && value%multiplier == 0 {
+			return fmt.Sprintf("%d%s", value/multiplier, unit)
+		}
+	}
+
+	return fmt.Sprintf("%dB", value)
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (s *Size) Set(v string) error {
+	size, e := ParseSize(v)
+	if e != nil {
+		return e
+	}
+
+	*s = size
+
+	return nil
+}
+
+// Type is only used in help text.
+func (s *Size) Type() string {
+	return "size"
+}
+
+// Completion is a [cobra.CompletionFunc] suggesting unit suffixes for a typed number.
+func (s *Size) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if toComplete == "" || strings.Trim(toComplete, "0123456789.") != "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, unit := range []string{"KiB", "MiB", "GiB", "TiB", "KB", "MB", "GB", "TB"} {
+		completions = append(completions, toComplete+unit)
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
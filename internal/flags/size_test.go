@@ -0,0 +1,59 @@
+package flags
+
+import (
+	"testing"
+)
+
+func TestSize(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		text    string
+		invalid bool
+	}{
+		{input: "0", want: 0, text: "0B"},
+		{input: "512", want: 512, text: "512B"},
+		{input: "512B", want: 512, text: "512B"},
+		{input: "1KiB", want: 1024, text: "1KiB"},
+		{input: "10MiB", want: 10 << 20, text: "10MiB"},
+		{input: "10mib", want: 10 << 20, text: "10MiB"},
+		{input: "1.5GiB", want: 3 << 29, text: "1536MiB"},
+		{input: "1KB", want: 1000, text: "1000B"},
+		{input: "2 MB", want: 2000000, text: "2000000B"},
+		{input: "4k", want: 4096, text: "4KiB"},
+		{input: "1TiB", want: 1 << 40, text: "1TiB"},
+		{input: "", invalid: true},
+		{input: "MiB", invalid: true},
+		{input: "10XB", invalid: true},
+		{input: "1.2.3MB", invalid: true},
+		{input: "-1MB", invalid: true},
+		{input: "9999999PiB", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var size Size
+
+			e := size.Set(test.input)
+			if test.invalid {
+				if e == nil {
+					t.Fatalf("expected error, received %d", size)
+				}
+
+				return
+			}
+
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if int64(size) != test.want {
+				t.Errorf("expected %d, received %d", test.want, size)
+			}
+
+			if size.String() != test.text {
+				t.Errorf("expected string %q, received %q", test.text, size.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package flags
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Stdin is the reader consumed by a [Text] value of "-".
+var Stdin io.Reader = os.Stdin
+
+// Text is a [pflag.Value] for string flags supporting indirection: "@<path>" reads the value from a file, "-" reads
+// it from standard-input, and "@@" escapes a literal leading "@". Any other value is used as-is.
+type Text string
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Text)(nil)
+
+// String is used both by fmt.Print and by Cobra in help text.
+func (t *Text) String() string {
+	return string(*t)
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (t *Text) Set(v string) error {
+	switch {
+	case v == "-":
+		content, e := io.ReadAll(Stdin)
+		if e != nil {
+			return fmt.Errorf("unable to read standard-input: %w", e)
+		}
+
+		*t = Text(content)
+	case strings.HasPrefix(v, "@@"):
+		*t = Text(v[1:])
+	case strings.HasPrefix(v, "@"):
+		path, e := Expand(v[1:])
+		if e != nil {
+			return e
+		}
+
+		content, e := os.ReadFile(path)
+		if e != nil {
+			return fmt.Errorf("unable to read %q: %w", path, e)
+		}
+
+		*t = Text(content)
+	default:
+		*t = Text(v)
+	}
+
+	return nil
+}
+
+// Type is only used in help text.
+func (t *Text) Type() string {
+	return "string|@file|-"
+}
+
+// Completion is a [cobra.CompletionFunc] completing file paths following a leading "@".
+func (t *Text) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if strings.HasPrefix(toComplete, "@") {
+		// Shells complete paths literally, so list the "@"-prefixed candidates directly.
+		directory, prefix := filepath.Split(toComplete[1:])
+
+		var location = directory
+		if location == "" {
+			location = "."
+		}
+
+		entries, e := os.ReadDir(location)
+		if e != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var completions []cobra.Completion
+		for _, entry := range entries {
+			if name := entry.Name(); strings.HasPrefix(name, prefix) {
+				if entry.IsDir() {
+					name += string(filepath.Separator)
+				}
+
+				completions = append(completions, "@"+directory+name)
+			}
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+
+	return []cobra.Completion{"-", "@"}, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
@@ -0,0 +1,60 @@
+package flags
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	directory := t.TempDir()
+	file := filepath.Join(directory, "message.txt")
+	if e := os.WriteFile(file, []byte("from a file\n"), 0o600); e != nil {
+		t.Fatal(e)
+	}
+
+	t.Setenv("HOME", directory)
+
+	previous := Stdin
+	t.Cleanup(func() { Stdin = previous })
+
+	Stdin = strings.NewReader("from standard-input")
+
+	tests := []struct {
+		input   string
+		want    string
+		invalid bool
+	}{
+		{input: "literal", want: "literal"},
+		{input: "", want: ""},
+		{input: "@" + file, want: "from a file\n"},
+		{input: "@~/message.txt", want: "from a file\n"},
+		{input: "@@handle", want: "@handle"},
+		{input: "-", want: "from standard-input"},
+		{input: "@" + filepath.Join(directory, "missing"), invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var text Text
+
+			e := text.Set(test.input)
+			if test.invalid {
+				if e == nil {
+					t.Fatalf("expected error, received %q", text)
+				}
+
+				return
+			}
+
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if text.String() != test.want {
+				t.Errorf("expected %q, received %q", test.want, text.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Layouts are the absolute time formats accepted by [Range], in order of precedence. Dates without a time zone
+// are interpreted in the local time zone.
+var Layouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02T15:04", "2006-01-02"}
+
+// Range is a [pflag.Value] for time ranges, such as those of a "--since" flag. Each bound is either an absolute
+// time (see [Layouts]) or a [Duration] before now (e.g. "2h", "1d12h"):
+//
+//   - "<bound>" ranges from the bound until now (e.g. "2h", "2024-01-01").
+//   - "<from>..<to>" ranges between two bounds; either may be omitted for an open-ended range (e.g. "7d..1d",
+//     "2024-01-01..2024-02-01", "..2024-01-01").
+//
+// Open bounds are represented by zero [time.Time] values.
+type Range struct {
+	From, To time.Time
+
+	raw string
+
+	Now func() time.Time // Now returns the reference time of relative bounds; defaults to [time.Now].
+}
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*Range)(nil)
+
+// Contains reports whether t is within the range; bounds are inclusive.
+func (r *Range) Contains(t time.Time) bool {
+	return (r.From.IsZero() || !t.Before(r.From)) && (r.To.IsZero() || !t.After(r.To))
+}
+
+// String is used both by fmt.Print and by Cobra in help text.
+func (r *Range) String() string {
+	return r.raw
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (r *Range) Set(v string) error {
+	now := time.Now
+	if r.Now != nil {
+		now = r.Now
+	}
+
+	reference := now()
+
+	bound := func(v string) (time.Time, error) {
+		if v = strings.TrimSpace(v); v == "" {
+			return time.Time{}, nil
+		}
+
+		for _, layout := range Layouts {
+			if t, e := time.ParseInLocation(layout, v, time.Local); e == nil {
+				return t, nil
+			}
+		}
+
+		duration, e := ParseDuration(v)
+		if e != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q: expected a duration (e.g. \"2h\") or a timestamp (e.g. \"2006-01-02\")", v)
+		}
+
+		return reference.Add(-duration), nil
+	}
+
+	var from, to time.Time
+	var e error
+
+	if start, end, found := strings.Cut(v, ".."); found {
+		if strings.TrimSpace(start) == "" && strings.TrimSpace(end) == "" {
+			return fmt.Errorf("invalid time range %q: at least one bound is required", v)
+		}
+
+		if from, e = bound(start); e != nil {
+			return e
+		}
+
+		if to, e = bound(end); e != nil {
+			return e
+		}
+	} else {
+		if strings.TrimSpace(v) == "" {
+			return fmt.Errorf("invalid time range %q", v)
+		}
+
+		if from, e = bound(v); e != nil {
+			return e
+		}
+
+		to = reference
+	}
+
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return fmt.Errorf("invalid time range %q: start is after end", v)
+	}
+
+	r.From, r.To, r.raw = from, to, v
+
+	return nil
+}
+
+// Type is only used in help text.
+func (r *Range) Type() string {
+	return "time-range"
+}
+
+// Completion is a [cobra.CompletionFunc] suggesting common relative ranges.
+func (r *Range) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return []cobra.Completion{"1h", "1d", "7d", "30d"}, cobra.ShellCompDirectiveNoFileComp
+}
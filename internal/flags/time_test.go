@@ -0,0 +1,74 @@
+package flags
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRange(t *testing.T) {
+	now := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	date := func(v string) time.Time {
+		t, e := time.ParseInLocation("2006-01-02", v, time.Local)
+		if e != nil {
+			panic(e)
+		}
+
+		return t
+	}
+
+	tests := []struct {
+		input    string
+		from, to time.Time
+		invalid  bool
+	}{
+		{input: "2h", from: now.Add(-2 * time.Hour), to: now},
+		{input: "1d12h", from: now.Add(-36 * time.Hour), to: now},
+		{input: "2024-06-01", from: date("2024-06-01"), to: now},
+		{input: "2024-06-01T08:00:00Z", from: time.Date(2024, time.June, 1, 8, 0, 0, 0, time.UTC), to: now},
+		{input: "7d..1d", from: now.Add(-7 * Day), to: now.Add(-Day)},
+		{input: "2024-01-01..2024-02-01", from: date("2024-01-01"), to: date("2024-02-01")},
+		{input: "..2024-01-01", to: date("2024-01-01")},
+		{input: "3h..", from: now.Add(-3 * time.Hour)},
+		{input: "", invalid: true},
+		{input: "..", invalid: true},
+		{input: "yesterday", invalid: true},
+		{input: "1d..7d", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var window = Range{Now: func() time.Time { return now }}
+
+			e := window.Set(test.input)
+			if test.invalid {
+				if e == nil {
+					t.Fatalf("expected error, received %s..%s", window.From, window.To)
+				}
+
+				return
+			}
+
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if !window.From.Equal(test.from) || !window.To.Equal(test.to) {
+				t.Errorf("expected %s..%s, received %s..%s", test.from, test.to, window.From, window.To)
+			}
+
+			if window.String() != test.input {
+				t.Errorf("expected string %q, received %q", test.input, window.String())
+			}
+		})
+	}
+
+	var window = Range{Now: func() time.Time { return now }}
+	if e := window.Set("1d"); e != nil {
+		t.Fatal(e)
+	}
+
+	if !window.Contains(now.Add(-time.Hour)) || window.Contains(now.Add(-2*Day)) || window.Contains(now.Add(time.Second)) {
+		t.Error("unexpected Contains results")
+	}
+}
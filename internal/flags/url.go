@@ -0,0 +1,65 @@
+package flags
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// URL is a [pflag.Value] for absolute URLs. If Schemes is non-empty, the URL's scheme must be one of them.
+type URL struct {
+	*url.URL
+
+	Schemes []string
+}
+
+// Runtime conformator to ensure implementation satisfies the interface.
+var _ pflag.Value = (*URL)(nil)
+
+// String is used both by fmt.Print and by Cobra in help text; credentials are redacted.
+func (u *URL) String() string {
+	if u.URL == nil {
+		return ""
+	}
+
+	return u.URL.Redacted()
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy.
+func (u *URL) Set(v string) error {
+	parsed, e := url.Parse(v)
+	if e != nil {
+		return fmt.Errorf("invalid url: %w", e)
+	}
+
+	if parsed.Scheme == "" || (parsed.Host == "" && parsed.Opaque == "" && parsed.Scheme != "file") {
+		return fmt.Errorf("invalid url %q: must be absolute (e.g. \"https://example.com\")", v)
+	}
+
+	if len(u.Schemes) > 0 && !slices.Contains(u.Schemes, strings.ToLower(parsed.Scheme)) {
+		return fmt.Errorf("invalid url %q: scheme must be one of %s", v, strings.Join(u.Schemes, ", "))
+	}
+
+	u.URL = parsed
+
+	return nil
+}
+
+// Type is only used in help text.
+func (u *URL) Type() string {
+	return "url"
+}
+
+// Completion is a [cobra.CompletionFunc] suggesting the permitted scheme prefixes.
+func (u *URL) Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	var completions []cobra.Completion
+	for _, scheme := range u.Schemes {
+		completions = append(completions, scheme+"://")
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
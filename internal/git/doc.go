@@ -0,0 +1,2 @@
+// Package git provides a minimal wrapper around the git executable.
+package git
@@ -0,0 +1,143 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"template-go-cli/internal/system"
+)
+
+// Repository represents a git working tree.
+type Repository struct {
+	Directory string // Directory is any path within the working tree.
+}
+
+// Open returns the [Repository] containing directory, failing if it isn't within a git working tree.
+func Open(ctx context.Context, directory string) (*Repository, error) {
+	repository := &Repository{Directory: directory}
+
+	if _, e := repository.Run(ctx, "rev-parse", "--is-inside-work-tree"); e != nil {
+		return nil, e
+	}
+
+	return repository, nil
+}
+
+// Run executes a git sub-command, with the context's environment (see [system.Get]), returning its trimmed
+// standard-output.
+func (r *Repository) Run(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	command := exec.CommandContext(ctx, "git", append([]string{"-C", r.Directory}, args...)...)
+	command.Env = system.Get(ctx).Environ()
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+
+	if e := command.Run(); e != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("git %s: %s: %w", args[0], message, e)
+		}
+
+		return "", fmt.Errorf("git %s: %w", args[0], e)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// Root returns the working tree's top-level directory.
+func (r *Repository) Root(ctx context.Context) (string, error) {
+	return r.Run(ctx, "rev-parse", "--show-toplevel")
+}
+
+// Clean reports whether the working tree has no modified, staged or untracked files; dirty paths are returned
+// otherwise.
+func (r *Repository) Clean(ctx context.Context) (bool, []string, error) {
+	status, e := r.Run(ctx, "status", "--porcelain")
+	if e != nil {
+		return false, nil, e
+	}
+
+	if status == "" {
+		return true, nil, nil
+	}
+
+	return false, strings.Split(status, "\n"), nil
+}
+
+// Commit stages the given paths and commits them with message.
+func (r *Repository) Commit(ctx context.Context, message string, paths ...string) error {
+	if _, e := r.Run(ctx, append([]string{"add", "--"}, paths...)...); e != nil {
+		return e
+	}
+
+	_, e := r.Run(ctx, "commit", "--message", message)
+
+	return e
+}
+
+// Tag creates an annotated tag at HEAD.
+func (r *Repository) Tag(ctx context.Context, name, message string) error {
+	_, e := r.Run(ctx, "tag", "--annotate", name, "--message", message)
+
+	return e
+}
+
+// Tags returns every tag, most recently created first.
+func (r *Repository) Tags(ctx context.Context) ([]string, error) {
+	output, e := r.Run(ctx, "tag", "--list", "--sort=-creatordate")
+	if e != nil || output == "" {
+		return nil, e
+	}
+
+	return strings.Split(output, "\n"), nil
+}
+
+// Entry represents a commit, as reported by [Repository.Log].
+type Entry struct {
+	Hash    string `json:"hash" yaml:"hash"`
+	Author  string `json:"author" yaml:"author"`
+	Date    string `json:"date" yaml:"date"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// Log returns the commits of a revision range (e.g. "v1.2.3..HEAD"), most recent first.
+func (r *Repository) Log(ctx context.Context, revisions string) ([]Entry, error) {
+	output, e := r.Run(ctx, "log", "--format=%H%x1f%aN%x1f%aI%x1f%B%x1e", revisions, "--")
+	if e != nil || output == "" {
+		return nil, e
+	}
+
+	var entries []Entry
+	for _, record := range strings.Split(output, "\x1e") {
+		fields := strings.SplitN(strings.TrimLeft(record, "\n"), "\x1f", 4)
+		if len(fields) != 4 {
+			continue
+		}
+
+		entries = append(entries, Entry{Hash: fields[0], Author: fields[1], Date: fields[2], Message: strings.TrimSpace(fields[3])})
+	}
+
+	return entries, nil
+}
+
+// Describe returns the most recent tag reachable from revision, or an empty string if there isn't one.
+func (r *Repository) Describe(ctx context.Context, revision string) (string, error) {
+	if output, e := r.Run(ctx, "tag", "--list", "--merged", revision); e != nil || output == "" {
+		return "", e
+	}
+
+	return r.Run(ctx, "describe", "--tags", "--abbrev=0", revision)
+}
+
+// Hooks returns the repository's hooks directory, honoring "core.hooksPath".
+func (r *Repository) Hooks(ctx context.Context) (string, error) {
+	path, e := r.Run(ctx, "rev-parse", "--path-format=absolute", "--git-path", "hooks")
+	if e != nil {
+		return "", e
+	}
+
+	return path, nil
+}
@@ -0,0 +1,6 @@
+// Package harness runs the cli in-process for tests: it builds the full root command (as the main package does),
+// executes it with the given arguments, environment and standard-input, and captures standard-output and
+// standard-error separately. Output is normalized (see [Normalize]) and compared against golden files (see
+// [Golden]); run "go test <package> -update" to rewrite a package's golden files, or "UPDATE_GOLDEN=1 go test ./..."
+// (or "make test-golden") to rewrite all of them.
+package harness
@@ -0,0 +1,109 @@
+package harness
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/diff"
+)
+
+// update rewrites golden files with the actual output, rather than comparing against them (e.g. "go test
+// ./internal/reference -update"). It defaults to the "UPDATE_GOLDEN" environment variable, which -- unlike the flag,
+// only accepted by test binaries linking the harness -- applies across packages (e.g. "UPDATE_GOLDEN=1 go test ./...").
+var update = flag.Bool("update", os.Getenv("UPDATE_GOLDEN") != "", "rewrite golden files with the actual output")
+
+// replacements normalize volatile output, applied in order.
+var replacements = []struct {
+	expression  *regexp.Regexp
+	replacement string
+}{
+	// Timestamps, as formatted by [logging.Replacements] (RFC3339) and elsewhere (RFC3339Nano).
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`), "<TIMESTAMP>"},
+
+	// Source locations, whose line numbers shift with every edit.
+	{regexp.MustCompile(`source=\S+`), "source=<SOURCE>"},
+
+	// Go toolchain versions (e.g. "go1.27.1").
+	{regexp.MustCompile(`\bgo\d+\.\d+(\.\d+)?\b`), "<GO-VERSION>"},
+}
+
+// Normalize replaces volatile data -- timestamps, source locations, toolchain and executable versions, and
+// temporary, home and working directory paths -- with stable placeholders.
+func Normalize(t testing.TB, output string) string {
+	t.Helper()
+
+	var paths = map[string]string{os.TempDir(): "<TMP>"}
+
+	if home, e := os.UserHomeDir(); e == nil {
+		paths[home] = "<HOME>"
+	}
+
+	if directory, e := os.Getwd(); e == nil {
+		paths[directory] = "<CWD>"
+	}
+
+	// Replace the longest paths first, as they may be nested within one another.
+	var ordered = make([]string, 0, len(paths))
+	for path := range paths {
+		if path != "" && path != "/" {
+			ordered = append(ordered, path)
+		}
+	}
+
+	slices.SortFunc(ordered, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	for _, path := range ordered {
+		output = strings.ReplaceAll(output, path, paths[path])
+	}
+
+	for _, r := range replacements {
+		output = r.expression.ReplaceAllString(output, r.replacement)
+	}
+
+	if build.Version != "" {
+		output = regexp.MustCompile(`\b`+regexp.QuoteMeta(build.Version)+`\b`).ReplaceAllString(output, "<VERSION>")
+	}
+
+	return strings.ReplaceAll(output, runtime.GOOS+"/"+runtime.GOARCH, "<PLATFORM>")
+}
+
+// Golden compares actual -- after [Normalize] -- against the golden file "testdata/<name>.golden", failing the
+// test with a unified diff upon mismatch. With the "-update" test flag, or "UPDATE_GOLDEN" set in the environment,
+// the golden file is (re)written instead.
+func Golden(t testing.TB, name string, actual string) {
+	t.Helper()
+
+	actual = Normalize(t, actual)
+
+	path := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		if e := os.MkdirAll(filepath.Dir(path), 0o755); e != nil {
+			t.Fatalf("unable to create golden file directory: %v", e)
+		}
+
+		if e := os.WriteFile(path, []byte(actual), 0o644); e != nil {
+			t.Fatalf("unable to write golden file: %v", e)
+		}
+
+		return
+	}
+
+	expected, e := os.ReadFile(path)
+	if e != nil {
+		t.Fatalf("unable to read golden file (run with -update or UPDATE_GOLDEN=1 to create it): %v", e)
+	}
+
+	if string(expected) != actual {
+		t.Errorf("output doesn't match golden file %q (run with -update or UPDATE_GOLDEN=1 to rewrite it):\n%s", path, diff.Unified(path, string(expected), actual))
+	}
+}
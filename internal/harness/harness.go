@@ -0,0 +1,71 @@
+package harness
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/cli"
+	"template-go-cli/internal/commands"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+)
+
+// Options configures an in-process execution.
+type Options struct {
+	Args  []string          // Args are the command-line arguments, excluding the executable's name.
+	Env   map[string]string // Env are additional environment variables, overriding the process'.
+	Stdin string            // Stdin is the standard-input content.
+	Home  string            // Home is the home directory, shared across executions; defaults to a temporary directory.
+}
+
+// Result represents an in-process execution's outcome.
+type Result struct {
+	Stdout string
+	Stderr string
+	Code   int // Code is the exit code.
+}
+
+// Run executes the cli's root command in-process, as the main package does. The execution is isolated from the
+// user's environment: the home, configuration, cache and state directories are temporary (unless [Options.Home] is
+// set), and the update notifier is disabled.
+func Run(t testing.TB, options Options) Result {
+	t.Helper()
+
+	home := options.Home
+	if home == "" {
+		home = t.TempDir()
+	}
+
+	var environment = map[string]string{
+		"HOME":                                   home,
+		"XDG_CONFIG_HOME":                        home + "/.config",
+		"XDG_CACHE_HOME":                         home + "/.cache",
+		"XDG_STATE_HOME":                         home + "/.local/state",
+		"NO_COLOR":                               "1",
+		constants.Prefix + "_NO_UPDATE_NOTIFIER": "1",
+		constants.Prefix + "_PLUGINS_DIRECTORY":  home + "/plugins",
+	}
+
+	for key, value := range options.Env {
+		environment[key] = value
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	root, e := cli.New(cli.Options{
+		Stdin:  strings.NewReader(options.Stdin),
+		Stdout: &stdout,
+		Stderr: &stderr,
+		// Later entries take precedence; the process' environment is retained for sub-processes (e.g. git's PATH).
+		Env: append(os.Environ(), system.Environment(environment)...),
+	})
+	if e != nil {
+		t.Fatalf("unable to build root command: %v", e)
+	}
+
+	code := commands.Run(root, options.Args)
+
+	return Result{Stdout: stdout.String(), Stderr: stderr.String(), Code: code}
+}
@@ -3,26 +3,29 @@ package logging
 import (
 	"context"
 	"log/slog"
-)
-
-// keyer is a custom type for context keys to prevent key collisions
-type keyer string
 
-const (
-	// key is the context key used to store and retrieve the logger
-	key keyer = "logging"
+	"template-go-cli/internal/contextual"
 )
 
+// key is the context key used to store and retrieve the logger; absent a value, the logger defaults to
+// [slog.Default].
+var key = contextual.New("logging", slog.Default)
+
 // With returns a new context with the provided logger stored in it.
 //
-// The logger can be later retrieved using the Logger function.
+// The logger can be later retrieved using the [Get] function.
 func With(ctx context.Context, logger *slog.Logger) context.Context {
-	return context.WithValue(ctx, key, logger)
+	return key.With(ctx, logger)
 }
 
 // Get retrieves the slog.Logger instance stored in the context.
 //
-// It panics if no logger was stored in the context.
+// It returns [slog.Default] if no logger was stored in the context.
 func Get(ctx context.Context) *slog.Logger {
-	return ctx.Value(key).(*slog.Logger)
+	return key.Value(ctx)
+}
+
+// Lookup retrieves the slog.Logger instance stored in the context, and whether one was stored.
+func Lookup(ctx context.Context) (*slog.Logger, bool) {
+	return key.Get(ctx)
 }
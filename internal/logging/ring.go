@@ -0,0 +1,174 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"template-go-cli/internal/contextual"
+	"template-go-cli/internal/redact"
+	"template-go-cli/internal/types/level"
+)
+
+// Ring is a bounded, in-memory log record buffer. Its [Ring.Handler] captures every record at trace level --
+// regardless of the configured log-level -- so that failures can be reported with full context (see [Ring.Flush])
+// without noisy output during successful runs.
+//
+// Once either the record count or the total byte size is exceeded, the oldest records are discarded.
+type Ring struct {
+	mutex   sync.Mutex
+	entries []entry
+	size    int
+	level   slog.Level // level is the level of the record being written; see [handler.Handle].
+
+	handling sync.Mutex // handling serializes the ring's handlers, as each sets the level of the record it writes.
+
+	count int // count is the maximum number of retained records.
+	bytes int // bytes is the maximum total size, in bytes, of all retained records.
+}
+
+// entry is a retained record, and its level.
+type entry struct {
+	level slog.Level
+	data  []byte
+}
+
+// NewRing creates a [Ring] retaining at most count records totalling at most bytes.
+func NewRing(count, bytes int) *Ring {
+	return &Ring{count: max(count, 1), bytes: max(bytes, 1), level: level.Trace.Level()}
+}
+
+// Write stores a copy of p as a single record, evicting the oldest record(s) as necessary. Unless written by the
+// ring's [Ring.Handler], the record is considered to be at trace level.
+func (r *Ring) Write(p []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.entries = append(r.entries, entry{level: r.level, data: bytes.Clone(p)})
+	r.size += len(p)
+
+	for len(r.entries) > 1 && (len(r.entries) > r.count || r.size > r.bytes) {
+		r.size -= len(r.entries[0].data)
+		r.entries = r.entries[1:]
+	}
+
+	return len(p), nil
+}
+
+// Handler returns a [slog.Handler] that formats records as text into the ring. The options' level is ignored;
+// records are always captured at trace level. Attributes are redacted (see [redact.Attribute]) following the
+// options' own replacements, as the ring's records may be persisted (e.g. in a crash report).
+func (r *Ring) Handler(options *slog.HandlerOptions) slog.Handler {
+	var copied slog.HandlerOptions
+	if options != nil {
+		copied = *options
+	}
+
+	copied.Level = level.Trace.Level()
+
+	replace := copied.ReplaceAttr
+
+	copied.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
+		if replace != nil {
+			a = replace(groups, a)
+		}
+
+		return redact.Attribute(groups, a)
+	}
+
+	return handler{Handler: slog.NewTextHandler(r, &copied), ring: r}
+}
+
+// handler is the [Ring.Handler]; it records the level of each record written into the ring.
+type handler struct {
+	slog.Handler
+
+	ring *Ring
+}
+
+func (h handler) Handle(ctx context.Context, record slog.Record) error {
+	h.ring.handling.Lock()
+	defer h.ring.handling.Unlock()
+
+	h.ring.mutex.Lock()
+	h.ring.level = record.Level
+	h.ring.mutex.Unlock()
+
+	defer func() {
+		h.ring.mutex.Lock()
+		h.ring.level = level.Trace.Level()
+		h.ring.mutex.Unlock()
+	}()
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return handler{Handler: h.Handler.WithAttrs(attrs), ring: h.ring}
+}
+
+func (h handler) WithGroup(name string) slog.Handler {
+	return handler{Handler: h.Handler.WithGroup(name), ring: h.ring}
+}
+
+// Entries returns the retained records, oldest first, with trailing newlines removed.
+func (r *Ring) Entries() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var entries = make([]string, 0, len(r.entries))
+	for _, entry := range r.entries {
+		entries = append(entries, string(bytes.TrimRight(entry.data, "\n")))
+	}
+
+	return entries
+}
+
+// Flush writes the retained records below the emitted level to w, and empties the ring. Records at or above it were
+// already emitted (e.g. by the handler the ring's is teed with; see [Tee]); a nil level flushes every record.
+func (r *Ring) Flush(w io.Writer, emitted slog.Leveler) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var entries []entry
+	for _, entry := range r.entries {
+		if emitted == nil || entry.level < emitted.Level() {
+			entries = append(entries, entry)
+		}
+	}
+
+	r.entries = nil
+	r.size = 0
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if _, e := fmt.Fprintf(w, "--- buffered log records (%d) ---\n", len(entries)); e != nil {
+		return e
+	}
+
+	for _, entry := range entries {
+		if _, e := w.Write(entry.data); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// ring is the context key used to store and retrieve the [Ring].
+var ring = contextual.New[*Ring]("ring", nil)
+
+// WithRing returns a new context with the provided ring stored in it.
+func WithRing(ctx context.Context, r *Ring) context.Context {
+	return ring.With(ctx, r)
+}
+
+// GetRing retrieves the [Ring] stored in the context, or nil if there isn't one.
+func GetRing(ctx context.Context) *Ring {
+	return ring.Value(ctx)
+}
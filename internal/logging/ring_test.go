@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/types/level"
+)
+
+func TestRing(t *testing.T) {
+	var emitted, flushed bytes.Buffer
+
+	ring := NewRing(10, 1<<10)
+
+	options := &slog.HandlerOptions{Level: slog.LevelInfo}
+
+	logger := slog.New(Tee(slog.NewTextHandler(&emitted, options), ring.Handler(options))).With(slog.String("command", "example"))
+
+	logger.Log(context.Background(), level.Trace.Level(), "tracing")
+	logger.Debug("debugging")
+	logger.Info("informing")
+	logger.Error("failing")
+
+	if entries := ring.Entries(); len(entries) != 4 {
+		t.Fatalf("expected every record to be retained, received %q", entries)
+	}
+
+	if e := ring.Flush(&flushed, options.Level); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, message := range []string{"tracing", "debugging"} {
+		if !strings.Contains(flushed.String(), message) || strings.Contains(emitted.String(), message) {
+			t.Errorf("expected %q to be flushed rather than emitted:\nflushed: %s\nemitted: %s", message, flushed.String(), emitted.String())
+		}
+	}
+
+	for _, message := range []string{"informing", "failing"} {
+		if strings.Contains(flushed.String(), message) || !strings.Contains(emitted.String(), message) {
+			t.Errorf("expected %q to be emitted rather than flushed:\nflushed: %s\nemitted: %s", message, flushed.String(), emitted.String())
+		}
+	}
+
+	if !strings.HasPrefix(flushed.String(), "--- buffered log records (2) ---\n") {
+		t.Errorf("unexpected flush header:\n%s", flushed.String())
+	}
+
+	if entries := ring.Entries(); len(entries) != 0 {
+		t.Fatalf("expected the flush to empty the ring, received %q", entries)
+	}
+}
+
+func TestRingFlushAll(t *testing.T) {
+	var flushed bytes.Buffer
+
+	ring := NewRing(2, 1<<10)
+
+	logger := slog.New(ring.Handler(nil))
+
+	logger.Info("first")
+	logger.Info("second")
+	logger.Error("third")
+
+	if e := ring.Flush(&flushed, nil); e != nil {
+		t.Fatal(e)
+	}
+
+	if strings.Contains(flushed.String(), "first") || !strings.Contains(flushed.String(), "second") || !strings.Contains(flushed.String(), "third") {
+		t.Fatalf("expected the oldest record to be evicted, and the rest flushed:\n%s", flushed.String())
+	}
+}
@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// tee is a [slog.Handler] that dispatches each record to every enabled handler.
+type tee []slog.Handler
+
+// Tee returns a [slog.Handler] that fans records out to all of the provided handlers. Each handler's own level
+// is respected.
+func Tee(handlers ...slog.Handler) slog.Handler {
+	return tee(handlers)
+}
+
+func (t tee) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range t {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t tee) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+
+	for _, handler := range t {
+		if handler.Enabled(ctx, record.Level) {
+			errs = append(errs, handler.Handle(ctx, record.Clone()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (t tee) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var handlers = make(tee, 0, len(t))
+	for _, handler := range t {
+		handlers = append(handlers, handler.WithAttrs(attrs))
+	}
+
+	return handlers
+}
+
+func (t tee) WithGroup(name string) slog.Handler {
+	var handlers = make(tee, 0, len(t))
+	for _, handler := range t {
+		handlers = append(handlers, handler.WithGroup(name))
+	}
+
+	return handlers
+}
@@ -0,0 +1,2 @@
+// Package paths resolves the cli's per-user file-system locations (configuration, plugins, etc.).
+package paths
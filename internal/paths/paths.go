@@ -0,0 +1,121 @@
+package paths
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+)
+
+// Config returns the cli's per-user configuration directory (e.g. "~/.config/template-go-cli"), as
+// [os.UserConfigDir] resolves it from the context's environment (see [system.Get]).
+func Config(ctx context.Context) (string, error) {
+	directory, e := user(ctx, "XDG_CONFIG_HOME", "AppData", "Library/Application Support", ".config")
+	if e != nil {
+		return "", fmt.Errorf("unable to resolve user configuration directory: %w", e)
+	}
+
+	return filepath.Join(directory, constants.Name), nil
+}
+
+// Plugins returns the directory searched for plugin executables prior to the system's PATH. The location
+// can be overwritten via the "<PREFIX>_PLUGINS_DIRECTORY" environment variable; otherwise, defaults to
+// a "plugins" directory relative to [Config].
+func Plugins(ctx context.Context) (string, error) {
+	if v := system.Get(ctx).Getenv(constants.Prefix + "_PLUGINS_DIRECTORY"); v != "" {
+		return v, nil
+	}
+
+	directory, e := Config(ctx)
+	if e != nil {
+		return "", e
+	}
+
+	return filepath.Join(directory, "plugins"), nil
+}
+
+// Cache returns the cli's per-user cache directory (e.g. "~/.cache/template-go-cli"), as [os.UserCacheDir]
+// resolves it from the context's environment.
+func Cache(ctx context.Context) (string, error) {
+	directory, e := user(ctx, "XDG_CACHE_HOME", "LocalAppData", "Library/Caches", ".cache")
+	if e != nil {
+		return "", fmt.Errorf("unable to resolve user cache directory: %w", e)
+	}
+
+	return filepath.Join(directory, constants.Name), nil
+}
+
+// State returns the cli's per-user state directory (e.g. "~/.local/state/template-go-cli"), honoring
+// "XDG_STATE_HOME". State is data that should persist between invocations but isn't configuration or a
+// disposable cache (e.g. history, the last update check).
+func State(ctx context.Context) (string, error) {
+	if v := system.Get(ctx).Getenv("XDG_STATE_HOME"); filepath.IsAbs(v) {
+		return filepath.Join(v, constants.Name), nil
+	}
+
+	home, e := Home(ctx)
+	if e != nil {
+		return "", fmt.Errorf("unable to resolve user state directory: %w", e)
+	}
+
+	return filepath.Join(home, ".local", "state", constants.Name), nil
+}
+
+// Home returns the user's home directory, as [os.UserHomeDir] resolves it from the context's environment.
+func Home(ctx context.Context) (string, error) {
+	variable := "HOME"
+
+	switch runtime.GOOS {
+	case "windows":
+		variable = "USERPROFILE"
+	case "plan9":
+		variable = "home"
+	}
+
+	if v := system.Get(ctx).Getenv(variable); v != "" {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("$%s is not defined", variable)
+}
+
+// user resolves a per-user base directory: the XDG variable (when absolute) on unix-like systems, the variable on
+// windows, and the given directory relative to the home directory on darwin (library) or elsewhere (fallback).
+func user(ctx context.Context, xdg, windows, library, fallback string) (string, error) {
+	var s = system.Get(ctx)
+
+	switch runtime.GOOS {
+	case "windows":
+		if v := s.Getenv(windows); v != "" {
+			return v, nil
+		}
+
+		return "", fmt.Errorf("%%%s%% is not defined", windows)
+	case "darwin", "ios":
+		home, e := Home(ctx)
+		if e != nil {
+			return "", e
+		}
+
+		return filepath.Join(home, filepath.FromSlash(library)), nil
+	}
+
+	if v := s.Getenv(xdg); v != "" {
+		if !filepath.IsAbs(v) {
+			return "", errors.New("path in $" + xdg + " is relative")
+		}
+
+		return v, nil
+	}
+
+	home, e := Home(ctx)
+	if e != nil {
+		return "", e
+	}
+
+	return filepath.Join(home, fallback), nil
+}
@@ -0,0 +1,196 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Annotation is the [cobra.Command.Annotations] key that marks a command as a plugin; its value is the plugin's path.
+const Annotation = "plugin"
+
+// Command constructs a pass-through cobra command that executes the plugin. All arguments following the plugin's
+// name are forwarded verbatim. The plugin inherits the context's environment (see [system.Get]), along with the
+// root command's resolved persistent flags as environment variables (see [Environment]).
+func (p Plugin) Command() *cobra.Command {
+	return &cobra.Command{
+		Use:                fmt.Sprintf("%s [flags] [arguments]", p.Name),
+		Short:              fmt.Sprintf("Plugin provided by %q", p.Path),
+		GroupID:            "plugins",
+		Annotations:        map[string]string{Annotation: p.Path},
+		DisableFlagParsing: true,
+		SilenceUsage:       true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			process := exec.CommandContext(ctx, p.Path, args...)
+			process.Stdin = cmd.InOrStdin()
+			process.Stdout = cmd.OutOrStdout()
+			process.Stderr = cmd.ErrOrStderr()
+			process.Env = append(system.Get(ctx).Environ(), Environment(cmd.Root())...)
+			process.Env = append(process.Env, fmt.Sprintf("%s_PLUGIN_NAME=%s", constants.Prefix, p.Name))
+
+			if executable, e := os.Executable(); e == nil {
+				process.Env = append(process.Env, fmt.Sprintf("%s_EXECUTABLE=%s", constants.Prefix, executable))
+			}
+
+			if e := process.Run(); e != nil {
+				exception := exceptions.Wrap(exceptions.Internal, e, "plugin %q failed", p.Name).WithDetail("path", p.Path)
+
+				// Propagate the plugin's own exit status.
+				var exit *exec.ExitError
+				if errors.As(e, &exit) && exit.ExitCode() > 0 {
+					exception.WithCode(exit.ExitCode())
+				}
+
+				return exception
+			}
+
+			return nil
+		},
+	}
+}
+
+// Environment converts the root command's persistent flags into "<PREFIX>_<FLAG>" environment variables,
+// e.g. "--log-level" becomes "TEMPLATE_GO_CLI_LOG_LEVEL".
+func Environment(root *cobra.Command) []string {
+	var environment []string
+
+	root.PersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden {
+			return
+		}
+
+		key := strings.ToUpper(strings.ReplaceAll(flag.Name, "-", "_"))
+
+		environment = append(environment, fmt.Sprintf("%s_%s=%s", constants.Prefix, key, flag.Value.String()))
+	})
+
+	return environment
+}
+
+// Builtins returns the set of names (including aliases) reserved by root's non-plugin sub-commands, along with
+// cobra's implicit "help" and "completion" commands.
+func Builtins(root *cobra.Command) map[string]bool {
+	var builtins = map[string]bool{"help": true, "completion": true, cobra.ShellCompRequestCmd: true, cobra.ShellCompNoDescRequestCmd: true}
+
+	for _, command := range root.Commands() {
+		if _, plugin := command.Annotations[Annotation]; plugin {
+			continue
+		}
+
+		builtins[command.Name()] = true
+		for _, alias := range command.Aliases {
+			builtins[alias] = true
+		}
+	}
+
+	return builtins
+}
+
+// Attach discovers plugins and adds every non-conflicting plugin as a sub-command of root; see [Resolve] for
+// attaching only the plugin an execution dispatches to.
+func Attach(ctx context.Context, root *cobra.Command) {
+	for _, plugin := range Discover(ctx, Builtins(root)) {
+		if plugin.Conflict {
+			continue
+		}
+
+		attach(root, plugin)
+	}
+}
+
+// Resolve prepares root for its execution with args. Plugins are only searched for when required: the plugin named
+// by args' sub-command -- unless it's a built-in -- is looked up (see [Lookup]) and attached, while every plugin is
+// attached (see [Attach]) for the root's help and shell completion requests, which list the available commands.
+func Resolve(ctx context.Context, root *cobra.Command, args []string) {
+	name := command(root, args)
+
+	switch name {
+	case "", "help", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		Attach(ctx, root)
+
+	default:
+		if Builtins(root)[name] {
+			return
+		}
+
+		if plugin, found := Lookup(ctx, name); found {
+			attach(root, plugin)
+		}
+	}
+}
+
+// attach adds the plugin as a sub-command of root, unless a command of the same name is already attached. A
+// "plugins" group is created along with the first plugin attached.
+func attach(root *cobra.Command, plugin Plugin) {
+	for _, command := range root.Commands() {
+		if command.Name() == plugin.Name {
+			return
+		}
+	}
+
+	if !root.ContainsGroup("plugins") {
+		root.AddGroup(&cobra.Group{ID: "plugins", Title: "Plugin Commands"})
+	}
+
+	root.AddCommand(plugin.Command())
+}
+
+// command returns the name of the sub-command args refer to -- their first positional argument, skipping root's
+// flags and their values as cobra does -- or an empty string if there isn't one.
+func command(root *cobra.Command, args []string) string {
+	lookup := func(name string) *pflag.Flag {
+		if flag := root.Flags().Lookup(name); flag != nil {
+			return flag
+		}
+
+		return root.PersistentFlags().Lookup(name)
+	}
+
+	shorthand := func(name string) *pflag.Flag {
+		if flag := root.Flags().ShorthandLookup(name); flag != nil {
+			return flag
+		}
+
+		return root.PersistentFlags().ShorthandLookup(name)
+	}
+
+	for i := 0; i < len(args); i++ {
+		argument := args[i]
+
+		switch {
+		case argument == "--":
+			return ""
+
+		case strings.HasPrefix(argument, "--"):
+			if flag := lookup(strings.TrimPrefix(argument, "--")); !strings.Contains(argument, "=") && flag != nil && flag.NoOptDefVal == "" {
+				i++
+			}
+
+		case strings.HasPrefix(argument, "-") && len(argument) > 1:
+			if len(argument) != 2 {
+				continue
+			}
+
+			if flag := shorthand(argument[1:]); flag != nil && flag.NoOptDefVal == "" {
+				i++
+			}
+
+		default:
+			return argument
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,10 @@
+// Package plugins discovers external, git-style plugin executables (e.g. "template-go-cli-<name>") and exposes
+// them as cobra sub-commands.
+//
+// Plugins are searched for in the plugin directory (see [paths.Plugins]) and then every entry of the system's PATH;
+// the first executable found for a given name wins, and any subsequent executable of the same name is considered
+// shadowed. Plugins whose name conflicts with a built-in command are never attached.
+//
+// The directories aren't listed upon every execution: only the plugin an execution dispatches to is looked up,
+// unless the execution lists the available commands (help and shell completion); see [Resolve].
+package plugins
@@ -0,0 +1,162 @@
+package plugins
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/paths"
+	"template-go-cli/internal/system"
+)
+
+// Plugin represents a discovered plugin executable.
+type Plugin struct {
+	Name     string   `json:"name" yaml:"name"`                             // Name is the sub-command name (the executable's name without its prefix).
+	Path     string   `json:"path" yaml:"path"`                             // Path is the absolute path to the resolved executable.
+	Shadowed []string `json:"shadowed,omitempty" yaml:"shadowed,omitempty"` // Shadowed lists executables of the same name that are overshadowed by Path.
+	Conflict bool     `json:"conflict" yaml:"conflict"`                     // Conflict is true when a built-in command of the same name exists; the plugin is then never attached.
+}
+
+// prefix is the executable-name prefix that identifies a plugin.
+const prefix = constants.Name + "-"
+
+// Directories returns the ordered list of directories searched for plugins, per the context's environment.
+func Directories(ctx context.Context) []string {
+	var directories []string
+
+	if directory, e := paths.Plugins(ctx); e == nil {
+		directories = append(directories, directory)
+	}
+
+	directories = append(directories, filepath.SplitList(system.Get(ctx).Getenv("PATH"))...)
+
+	return directories
+}
+
+// Discover searches [Directories] -- via the context's filesystem -- for plugin executables. Names contained in
+// builtins are flagged as conflicts. Unreadable or missing directories are skipped. The returned slice is sorted
+// by name.
+func Discover(ctx context.Context, builtins map[string]bool) []Plugin {
+	var mapping = map[string]*Plugin{}
+
+	var filesystem = system.Get(ctx).Filesystem
+
+	for _, directory := range Directories(ctx) {
+		if directory == "" {
+			continue
+		}
+
+		entries, e := filesystem.ReadDir(directory)
+		if e != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, valid := trim(entry.Name())
+			if !valid {
+				continue
+			}
+
+			path := filepath.Join(directory, entry.Name())
+			if !executable(filesystem, path) {
+				continue
+			}
+
+			if absolute, e := filepath.Abs(path); e == nil {
+				path = absolute
+			}
+
+			if plugin, exists := mapping[name]; exists {
+				if plugin.Path != path {
+					plugin.Shadowed = append(plugin.Shadowed, path)
+				}
+
+				continue
+			}
+
+			mapping[name] = &Plugin{Name: name, Path: path, Conflict: builtins[name]}
+		}
+	}
+
+	var plugins = make([]Plugin, 0, len(mapping))
+	for _, plugin := range mapping {
+		plugins = append(plugins, *plugin)
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugins
+}
+
+// Lookup searches [Directories] -- via the context's filesystem -- for the named plugin's executable, without listing
+// the directories' entries. As with [Discover], the first executable found wins; unlike it, shadowed executables
+// aren't collected and conflicts aren't flagged.
+func Lookup(ctx context.Context, name string) (Plugin, bool) {
+	if _, valid := trim(prefix + name); !valid || strings.ContainsAny(name, `/\`) {
+		return Plugin{}, false
+	}
+
+	var filename = prefix + name
+	if runtime.GOOS == "windows" {
+		filename += ".exe"
+	}
+
+	var filesystem = system.Get(ctx).Filesystem
+
+	for _, directory := range Directories(ctx) {
+		if directory == "" {
+			continue
+		}
+
+		path := filepath.Join(directory, filename)
+		if !executable(filesystem, path) {
+			continue
+		}
+
+		if absolute, e := filepath.Abs(path); e == nil {
+			path = absolute
+		}
+
+		return Plugin{Name: name, Path: path}, true
+	}
+
+	return Plugin{}, false
+}
+
+// trim strips the plugin prefix (and windows' executable extension) from a file name, reporting whether the
+// file name represents a plugin at all.
+func trim(filename string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		filename = strings.TrimSuffix(filename, ".exe")
+	}
+
+	if !strings.HasPrefix(filename, prefix) {
+		return "", false
+	}
+
+	name := strings.TrimPrefix(filename, prefix)
+	if name == "" || strings.HasPrefix(name, "-") {
+		return "", false
+	}
+
+	return name, true
+}
+
+// executable reports whether path is a regular (or symlinked) file with an executable permission bit.
+func executable(filesystem system.Filesystem, path string) bool {
+	information, e := filesystem.Stat(path)
+	if e != nil || !information.Mode().IsRegular() {
+		return false
+	}
+
+	if runtime.GOOS == "windows" {
+		return true
+	}
+
+	return information.Mode().Perm()&0o111 != 0
+}
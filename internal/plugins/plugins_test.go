@@ -0,0 +1,181 @@
+package plugins
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+
+	"github.com/spf13/cobra"
+)
+
+// install writes an executable (or, unless executable, a non-executable) plugin file named after the plugin into
+// directory, returning its path.
+func install(t *testing.T, directory, name string, executable bool) string {
+	t.Helper()
+
+	var mode os.FileMode = 0o644
+	if executable {
+		mode = 0o755
+	}
+
+	path := filepath.Join(directory, prefix+name)
+	if e := os.WriteFile(path, []byte("#!/bin/sh\n"), mode); e != nil {
+		t.Fatal(e)
+	}
+
+	return path
+}
+
+// environment returns a context whose system searches the plugin directory and then the PATH directories.
+func environment(t *testing.T, directory string, path ...string) context.Context {
+	variables := map[string]string{
+		"HOME":                                  t.TempDir(),
+		"PATH":                                  strings.Join(path, string(os.PathListSeparator)),
+		constants.Prefix + "_PLUGINS_DIRECTORY": directory,
+	}
+
+	return system.With(context.Background(), system.System{Env: system.Environment(variables)})
+}
+
+// root returns a root command with a built-in "version" sub-command, a "--output" flag and a "--verbose" switch.
+func root() *cobra.Command {
+	root := &cobra.Command{Use: constants.Name}
+
+	root.PersistentFlags().StringP("output", "o", "json", "")
+	root.PersistentFlags().BoolP("verbose", "v", false, "")
+
+	root.AddCommand(&cobra.Command{Use: "version", Aliases: []string{"v"}, Run: func(*cobra.Command, []string) {}})
+
+	return root
+}
+
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires executable permission bits")
+	}
+
+	directory, first, second := t.TempDir(), t.TempDir(), t.TempDir()
+
+	hello := install(t, directory, "hello", true)
+	shadowed := install(t, first, "hello", true)
+	world := install(t, second, "world", true)
+
+	install(t, first, "version", true)
+	install(t, first, "disabled", false)
+
+	if e := os.WriteFile(filepath.Join(first, constants.Name), nil, 0o755); e != nil {
+		t.Fatal(e)
+	}
+
+	ctx := environment(t, directory, first, "", filepath.Join(second, "missing"), second)
+
+	discovered := Discover(ctx, Builtins(root()))
+
+	expected := []Plugin{
+		{Name: "hello", Path: hello, Shadowed: []string{shadowed}},
+		{Name: "version", Path: filepath.Join(first, prefix+"version"), Conflict: true},
+		{Name: "world", Path: world},
+	}
+
+	if len(discovered) != len(expected) {
+		t.Fatalf("expected %+v, received %+v", expected, discovered)
+	}
+
+	for i, plugin := range discovered {
+		if plugin.Name != expected[i].Name || plugin.Path != expected[i].Path || plugin.Conflict != expected[i].Conflict || strings.Join(plugin.Shadowed, ",") != strings.Join(expected[i].Shadowed, ",") {
+			t.Errorf("expected %+v, received %+v", expected[i], plugin)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires executable permission bits")
+	}
+
+	directory, first := t.TempDir(), t.TempDir()
+
+	hello := install(t, directory, "hello", true)
+
+	install(t, first, "hello", true)
+	install(t, first, "disabled", false)
+
+	ctx := environment(t, directory, first)
+
+	tests := []struct {
+		name  string
+		path  string
+		found bool
+	}{
+		{name: "hello", path: hello, found: true},
+		{name: "disabled"},
+		{name: "missing"},
+		{name: ""},
+		{name: "-hello"},
+		{name: "../hello"},
+	}
+
+	for _, test := range tests {
+		plugin, found := Lookup(ctx, test.name)
+		if found != test.found || plugin.Path != test.path {
+			t.Errorf("expected %q to resolve to %q (%t), received %q (%t)", test.name, test.path, test.found, plugin.Path, found)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires executable permission bits")
+	}
+
+	directory := t.TempDir()
+
+	install(t, directory, "hello", true)
+	install(t, directory, "world", true)
+	install(t, directory, "version", true)
+
+	ctx := environment(t, directory)
+
+	tests := []struct {
+		name     string
+		args     []string
+		attached []string
+	}{
+		{name: "plugin", args: []string{"hello", "--flag", "argument"}, attached: []string{"hello"}},
+		{name: "flags", args: []string{"--output", "yaml", "-v", "-o", "json", "--output=json", "-oyaml", "hello"}, attached: []string{"hello"}},
+		{name: "builtin", args: []string{"version", "hello"}},
+		{name: "alias", args: []string{"v"}},
+		{name: "unknown", args: []string{"missing"}},
+		{name: "terminator", args: []string{"--", "hello"}, attached: []string{"hello", "world"}},
+		{name: "flag-value", args: []string{"--output", "hello"}, attached: []string{"hello", "world"}},
+		{name: "help", args: []string{"help"}, attached: []string{"hello", "world"}},
+		{name: "completion", args: []string{cobra.ShellCompRequestCmd, ""}, attached: []string{"hello", "world"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := root()
+
+			// Resolving is idempotent.
+			Resolve(ctx, root, test.args)
+			Resolve(ctx, root, test.args)
+
+			var attached []string
+			for _, command := range root.Commands() {
+				if _, plugin := command.Annotations[Annotation]; plugin {
+					attached = append(attached, command.Name())
+				}
+			}
+
+			if strings.Join(attached, ",") != strings.Join(test.attached, ",") {
+				t.Fatalf("expected the %q plugin(s) to be attached, received %q", test.attached, attached)
+			}
+		})
+	}
+}
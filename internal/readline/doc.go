@@ -0,0 +1,4 @@
+// Package readline implements a minimal, dependency-free line editor: cursor movement, Emacs-style editing
+// shortcuts, persistent history and tab completion over a raw-mode terminal, falling back to line-buffered input
+// when the input isn't a terminal. See [Editor].
+package readline
@@ -0,0 +1,357 @@
+package readline
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"template-go-cli/internal/terminal"
+)
+
+// ErrInterrupted is returned by [Editor.Read] when the line is abandoned via Ctrl-C.
+var ErrInterrupted = errors.New("interrupted")
+
+// Candidate is a completion candidate.
+type Candidate struct {
+	Value       string
+	Description string
+}
+
+// Completion replaces the line's text from Start up to the cursor with one of its candidates.
+type Completion struct {
+	Start      int         // Start is the byte offset, within the line, of the text being completed.
+	Candidates []Candidate // Candidates are the possible replacements.
+	Space      bool        // Space appends a space to a sole candidate.
+}
+
+// Completer returns the [Completion] for line -- the text preceding the cursor.
+type Completer func(line string) Completion
+
+// Editor reads lines from a terminal, supporting the following key bindings:
+//
+//   - Left, Right, Ctrl-B, Ctrl-F: move the cursor by character
+//   - Home, End, Ctrl-A, Ctrl-E: move the cursor to the line's start or end
+//   - Up, Down, Ctrl-P, Ctrl-N: navigate the history
+//   - Backspace, Delete: delete the character before, or under, the cursor
+//   - Ctrl-K, Ctrl-U, Ctrl-W: delete to the line's end, to the line's start, or the preceding word
+//   - Ctrl-L: clear the screen
+//   - Tab: complete (see [Completer]), listing the candidates when ambiguous
+//   - Ctrl-C: abandon the line (see [ErrInterrupted])
+//   - Ctrl-D: end the input when the line is empty; otherwise, delete the character under the cursor
+//
+// When the input isn't a terminal, lines are read as-is.
+type Editor struct {
+	Input    io.Reader // Input is edited in raw mode when it's an [*os.File] attached to a terminal.
+	Output   io.Writer
+	Prompt   string
+	History  *History  // History is optional.
+	Complete Completer // Complete is optional.
+
+	reader *bufio.Reader
+
+	mutex   sync.Mutex
+	restore func() error // restore restores the terminal's state while it's in raw mode.
+}
+
+// line is the state of the line being edited.
+type line struct {
+	buffer []rune
+	cursor int
+}
+
+// Read prompts for, and returns, the next line. The terminal is in raw mode only for the read's duration. [io.EOF]
+// is returned at the end of the input.
+func (e *Editor) Read() (string, error) {
+	if e.reader == nil {
+		e.reader = bufio.NewReader(e.Input)
+	}
+
+	file, ok := e.Input.(*os.File)
+	if !ok {
+		return e.fallback(false)
+	}
+
+	restore, failure := terminal.Raw(file)
+	if failure != nil {
+		return e.fallback(terminal.Interactive(file))
+	}
+
+	e.mutex.Lock()
+	e.restore = restore
+	e.mutex.Unlock()
+
+	defer e.Restore()
+
+	return e.edit()
+}
+
+// Restore restores the terminal's state if a [Editor.Read] is in progress. It's safe to call concurrently, e.g.
+// prior to exiting upon a signal.
+func (e *Editor) Restore() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.restore != nil {
+		_ = e.restore()
+
+		e.restore = nil
+	}
+}
+
+// fallback reads a line without editing, prompting only when the input is interactive.
+func (e *Editor) fallback(interactive bool) (string, error) {
+	if interactive {
+		fmt.Fprint(e.Output, e.Prompt)
+	}
+
+	text, failure := e.reader.ReadString('\n')
+	if failure != nil && (failure != io.EOF || text == "") {
+		return "", failure
+	}
+
+	return strings.TrimRight(text, "\r\n"), nil
+}
+
+// edit reads a line from the raw-mode terminal.
+func (e *Editor) edit() (string, error) {
+	var current line
+
+	// position is the history entry being edited; len(entries) is the new line, whose content is retained in draft.
+	var entries []string
+	if e.History != nil {
+		entries = e.History.Entries()
+	}
+
+	position, draft := len(entries), ""
+
+	recall := func(target int) {
+		if target < 0 || target > len(entries) || target == position {
+			return
+		}
+
+		if position == len(entries) {
+			draft = string(current.buffer)
+		}
+
+		position = target
+
+		text := draft
+		if target < len(entries) {
+			text = entries[target]
+		}
+
+		current.buffer, current.cursor = []rune(text), len([]rune(text))
+	}
+
+	e.render(&current)
+
+	for {
+		r, _, failure := e.reader.ReadRune()
+		if failure != nil {
+			return "", failure
+		}
+
+		switch r {
+		case '\r', '\n':
+			fmt.Fprint(e.Output, "\r\n")
+
+			return string(current.buffer), nil
+		case 0x03: // Ctrl-C
+			fmt.Fprint(e.Output, "^C\r\n")
+
+			return "", ErrInterrupted
+		case 0x04: // Ctrl-D
+			if len(current.buffer) == 0 {
+				fmt.Fprint(e.Output, "\r\n")
+
+				return "", io.EOF
+			}
+
+			current.delete(current.cursor)
+		case 0x01: // Ctrl-A
+			current.cursor = 0
+		case 0x05: // Ctrl-E
+			current.cursor = len(current.buffer)
+		case 0x02: // Ctrl-B
+			current.cursor = max(current.cursor-1, 0)
+		case 0x06: // Ctrl-F
+			current.cursor = min(current.cursor+1, len(current.buffer))
+		case 0x10: // Ctrl-P
+			recall(position - 1)
+		case 0x0e: // Ctrl-N
+			recall(position + 1)
+		case 0x7f, 0x08: // Backspace
+			if current.cursor > 0 {
+				current.cursor--
+				current.delete(current.cursor)
+			}
+		case 0x0b: // Ctrl-K
+			current.buffer = current.buffer[:current.cursor]
+		case 0x15: // Ctrl-U
+			current.buffer, current.cursor = current.buffer[current.cursor:], 0
+		case 0x17: // Ctrl-W
+			start := current.cursor
+			for start > 0 && unicode.IsSpace(current.buffer[start-1]) {
+				start--
+			}
+
+			for start > 0 && !unicode.IsSpace(current.buffer[start-1]) {
+				start--
+			}
+
+			current.buffer = append(current.buffer[:start], current.buffer[current.cursor:]...)
+			current.cursor = start
+		case 0x0c: // Ctrl-L
+			fmt.Fprint(e.Output, "\x1b[H\x1b[2J")
+		case '\t':
+			e.complete(&current)
+		case 0x1b: // Escape sequence
+			switch e.escape() {
+			case "[A", "OA":
+				recall(position - 1)
+			case "[B", "OB":
+				recall(position + 1)
+			case "[C", "OC":
+				current.cursor = min(current.cursor+1, len(current.buffer))
+			case "[D", "OD":
+				current.cursor = max(current.cursor-1, 0)
+			case "[H", "OH", "[1~", "[7~":
+				current.cursor = 0
+			case "[F", "OF", "[4~", "[8~":
+				current.cursor = len(current.buffer)
+			case "[3~":
+				current.delete(current.cursor)
+			}
+		default:
+			if unicode.IsPrint(r) {
+				current.insert([]rune{r})
+			}
+		}
+
+		e.render(&current)
+	}
+}
+
+// escape reads the remainder of a CSI ("ESC [") or SS3 ("ESC O") escape sequence, returning it without the
+// leading escape.
+func (e *Editor) escape() string {
+	introducer, _, failure := e.reader.ReadRune()
+	if failure != nil || (introducer != '[' && introducer != 'O') {
+		return ""
+	}
+
+	var sequence = []rune{introducer}
+
+	for {
+		r, _, failure := e.reader.ReadRune()
+		if failure != nil {
+			return ""
+		}
+
+		sequence = append(sequence, r)
+
+		// A final byte terminates the sequence; SS3 sequences are a single byte.
+		if (r >= 0x40 && r <= 0x7e) || introducer == 'O' {
+			return string(sequence)
+		}
+	}
+}
+
+// render redraws the prompt and line, positioning the cursor.
+func (e *Editor) render(current *line) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "\r%s%s\x1b[K", e.Prompt, string(current.buffer))
+
+	if trailing := len(current.buffer) - current.cursor; trailing > 0 {
+		fmt.Fprintf(&b, "\x1b[%dD", trailing)
+	}
+
+	fmt.Fprint(e.Output, b.String())
+}
+
+// complete applies the completer's candidates: a sole candidate -- or the candidates' common prefix -- replaces
+// the text being completed; otherwise, the candidates are listed.
+func (e *Editor) complete(current *line) {
+	if e.Complete == nil {
+		return
+	}
+
+	before := string(current.buffer[:current.cursor])
+
+	completion := e.Complete(before)
+	if len(completion.Candidates) == 0 || completion.Start < 0 || completion.Start > len(before) {
+		fmt.Fprint(e.Output, "\a")
+
+		return
+	}
+
+	word := before[completion.Start:]
+
+	replacement := completion.Candidates[0].Value
+	for _, candidate := range completion.Candidates[1:] {
+		replacement = prefix(replacement, candidate.Value)
+	}
+
+	if len(completion.Candidates) == 1 && completion.Space {
+		replacement += " "
+	}
+
+	if replacement != word && strings.HasPrefix(replacement, word) {
+		current.insert([]rune(replacement[len(word):]))
+
+		return
+	}
+
+	if len(completion.Candidates) == 1 {
+		return
+	}
+
+	width := 0
+	for _, candidate := range completion.Candidates {
+		width = max(width, len(candidate.Value))
+	}
+
+	fmt.Fprint(e.Output, "\r\n")
+
+	for _, candidate := range completion.Candidates {
+		if candidate.Description == "" {
+			fmt.Fprintf(e.Output, "%s\r\n", candidate.Value)
+		} else {
+			fmt.Fprintf(e.Output, "%-*s  %s\r\n", width, candidate.Value, candidate.Description)
+		}
+	}
+}
+
+// insert inserts runes at the cursor, advancing it.
+func (l *line) insert(runes []rune) {
+	l.buffer = append(l.buffer[:l.cursor], append(runes, l.buffer[l.cursor:]...)...)
+	l.cursor += len(runes)
+}
+
+// delete removes the rune at index, if any.
+func (l *line) delete(index int) {
+	if index < len(l.buffer) {
+		l.buffer = append(l.buffer[:index], l.buffer[index+1:]...)
+	}
+}
+
+// prefix returns the longest common prefix of a and b, without splitting a multi-byte rune.
+func prefix(a, b string) string {
+	var n int
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+
+	for n > 0 && n < len(a) && !utf8.RuneStart(a[n]) {
+		n--
+	}
+
+	return a[:n]
+}
@@ -0,0 +1,132 @@
+package readline
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// History is an editor's line history, optionally persisted to a file -- one entry per line.
+type History struct {
+	Path  string // Path is the history file; an empty path disables persistence.
+	Limit int    // Limit is the maximum number of retained entries; zero is unlimited.
+
+	entries []string
+	written int // written is the number of entries appended to the file since it was last compacted.
+}
+
+// Load returns the history persisted at path, retaining at most limit of its most recent entries. A missing
+// file is an empty history.
+func Load(path string, limit int) (*History, error) {
+	var history = &History{Path: path, Limit: limit}
+
+	file, e := os.Open(path)
+	if errors.Is(e, fs.ErrNotExist) {
+		return history, nil
+	} else if e != nil {
+		return history, e
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			history.entries = append(history.entries, line)
+		}
+	}
+
+	history.written = len(history.entries)
+	history.trim()
+
+	return history, scanner.Err()
+}
+
+// Entries returns the history's entries, oldest first.
+func (h *History) Entries() []string {
+	return h.entries
+}
+
+// Add appends line to the history and its file. Blank lines, lines with a leading space (by convention, excluded
+// from history) and repeats of the most recent entry are ignored.
+func (h *History) Add(line string) error {
+	if strings.TrimSpace(line) == "" || strings.HasPrefix(line, " ") || strings.ContainsAny(line, "\r\n") {
+		return nil
+	}
+
+	if n := len(h.entries); n > 0 && h.entries[n-1] == line {
+		return nil
+	}
+
+	h.entries = append(h.entries, line)
+	h.trim()
+
+	if h.Path == "" {
+		return nil
+	}
+
+	// Rewrite, rather than append to, a file that has grown well beyond the limit.
+	if h.written++; h.Limit > 0 && h.written > 2*h.Limit {
+		return h.compact()
+	}
+
+	if e := os.MkdirAll(filepath.Dir(h.Path), 0o700); e != nil {
+		return e
+	}
+
+	file, e := os.OpenFile(h.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if e != nil {
+		return e
+	}
+
+	if _, e := file.WriteString(line + "\n"); e != nil {
+		file.Close()
+
+		return e
+	}
+
+	return file.Close()
+}
+
+// trim discards the oldest entries beyond the limit.
+func (h *History) trim() {
+	if h.Limit > 0 && len(h.entries) > h.Limit {
+		h.entries = h.entries[len(h.entries)-h.Limit:]
+	}
+}
+
+// compact atomically rewrites the history file with the retained entries.
+func (h *History) compact() error {
+	if e := os.MkdirAll(filepath.Dir(h.Path), 0o700); e != nil {
+		return e
+	}
+
+	file, e := os.CreateTemp(filepath.Dir(h.Path), ".history-*")
+	if e != nil {
+		return e
+	}
+
+	defer os.Remove(file.Name())
+
+	writer := bufio.NewWriter(file)
+	for _, entry := range h.entries {
+		writer.WriteString(entry + "\n")
+	}
+
+	if e := writer.Flush(); e != nil {
+		file.Close()
+
+		return e
+	}
+
+	if e := file.Close(); e != nil {
+		return e
+	}
+
+	h.written = len(h.entries)
+
+	return os.Rename(file.Name(), h.Path)
+}
@@ -0,0 +1,60 @@
+package readline
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "history")
+
+	history, e := Load(path, 3)
+	if e != nil {
+		t.Fatalf("unexpected error loading a missing history: %v", e)
+	}
+
+	for _, line := range []string{"one", "", "   ", " hidden", "two", "two", "three", "four"} {
+		if e := history.Add(line); e != nil {
+			t.Fatalf("unexpected error adding %q: %v", line, e)
+		}
+	}
+
+	want := []string{"two", "three", "four"}
+	if got := history.Entries(); !slices.Equal(got, want) {
+		t.Fatalf("expected entries %q, received %q", want, got)
+	}
+
+	reloaded, e := Load(path, 3)
+	if e != nil {
+		t.Fatalf("unexpected error reloading history: %v", e)
+	}
+
+	if got := reloaded.Entries(); !slices.Equal(got, want) {
+		t.Fatalf("expected reloaded entries %q, received %q", want, got)
+	}
+}
+
+func TestHistoryCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+
+	history := &History{Path: path, Limit: 2}
+
+	for _, line := range []string{"a", "b", "c", "d", "e"} {
+		if e := history.Add(line); e != nil {
+			t.Fatalf("unexpected error adding %q: %v", line, e)
+		}
+	}
+
+	content, e := os.ReadFile(path)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	// The fifth entry exceeds twice the limit, rewriting the file with the retained entries.
+	if got := strings.Fields(string(content)); !slices.Equal(got, []string{"d", "e"}) {
+		t.Fatalf("expected the compacted file to hold [d e], received %q", got)
+	}
+}
@@ -0,0 +1,6 @@
+// Package rebrand rewrites a checkout of the template into a new cli: the go module path, the executable's name and
+// environment variable prefix -- wherever go sources, test scripts and golden files reference them -- goreleaser's
+// project and cask names, the Makefile's name and the README's placeholders.
+//
+// Current values are detected from the checkout itself, so re-branding an already re-branded checkout works.
+package rebrand
@@ -0,0 +1,296 @@
+package rebrand
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"template-go-cli/internal/diff"
+	"template-go-cli/internal/system"
+)
+
+// Options represents the new brand.
+type Options struct {
+	Module string // Module is the new go module path (e.g. "github.com/example/tool").
+	Name   string // Name is the new executable and project name (e.g. "tool").
+	Owner  string // Owner is the optional GitHub owner (user or organization) hosting the project and homebrew tap.
+}
+
+// Brand represents the checkout's current (detected) values.
+type Brand struct {
+	Module  string `json:"module" yaml:"module"`
+	Name    string `json:"name" yaml:"name"`
+	Prefix  string `json:"prefix" yaml:"prefix"`   // Prefix is the environment variable prefix.
+	Project string `json:"project" yaml:"project"` // Project is goreleaser's project name.
+	Owner   string `json:"owner" yaml:"owner"`     // Owner is the goreleaser cask repository's owner.
+}
+
+// Change represents a single file's rewrite.
+type Change struct {
+	Path   string `json:"path" yaml:"path"`
+	Before string `json:"-" yaml:"-"`
+	After  string `json:"-" yaml:"-"`
+}
+
+// Diff returns the change's unified diff.
+func (c Change) Diff() string {
+	return diff.Unified(filepath.ToSlash(c.Path), c.Before, c.After)
+}
+
+var (
+	modules  = regexp.MustCompile(`(?m)^module[ \t]+(\S+)[ \t]*$`)
+	names    = regexp.MustCompile(`(?m)^(\s*Name\s*=\s*)"([^"]+)"`)
+	prefixes = regexp.MustCompile(`(?m)^(\s*Prefix\s*=\s*)"([^"]+)"`)
+	projects = regexp.MustCompile(`(?m)^project_name:\s*(\S+)[ \t]*$`)
+	owners   = regexp.MustCompile(`(?m)^(\s*owner:\s*)(\S+)[ \t]*$`)
+	makefile = regexp.MustCompile(`(?m)^(name\s*:=\s*)(\S+)[ \t]*$`)
+	valid    = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+)
+
+// Detect reads the checkout's current [Brand] from the filesystem.
+func Detect(filesystem system.Filesystem, root string) (Brand, error) {
+	var brand Brand
+
+	content, e := filesystem.ReadFile(filepath.Join(root, "go.mod"))
+	if e != nil {
+		return brand, fmt.Errorf("unable to read go.mod; is %q the repository root?: %w", root, e)
+	}
+
+	if match := modules.FindSubmatch(content); match != nil {
+		brand.Module = string(match[1])
+	} else {
+		return brand, errors.New("go.mod is missing a module directive")
+	}
+
+	content, e = filesystem.ReadFile(filepath.Join(root, "internal", "constants", "constants.go"))
+	if e != nil {
+		return brand, fmt.Errorf("unable to read constants: %w", e)
+	}
+
+	if match := names.FindSubmatch(content); match != nil {
+		brand.Name = string(match[2])
+	} else {
+		return brand, errors.New("unable to detect the executable's name from constants")
+	}
+
+	if match := prefixes.FindSubmatch(content); match != nil {
+		brand.Prefix = string(match[2])
+	}
+
+	if content, e := filesystem.ReadFile(filepath.Join(root, ".goreleaser.yml")); e == nil {
+		if match := projects.FindSubmatch(content); match != nil {
+			brand.Project = string(match[1])
+		}
+
+		if match := owners.FindSubmatch(content); match != nil {
+			brand.Owner = string(match[2])
+		}
+	}
+
+	return brand, nil
+}
+
+// Plan computes every file rewrite without modifying the checkout.
+func Plan(filesystem system.Filesystem, root string, options Options) ([]Change, error) {
+	if !valid.MatchString(options.Name) {
+		return nil, fmt.Errorf("invalid name %q: must be lowercase, hyphen-delimited words", options.Name)
+	}
+
+	if options.Module == "" || strings.ContainsAny(options.Module, " \t\"") {
+		return nil, fmt.Errorf("invalid module path %q", options.Module)
+	}
+
+	current, e := Detect(filesystem, root)
+	if e != nil {
+		return nil, e
+	}
+
+	if options.Owner == "" {
+		options.Owner = current.Owner
+	}
+
+	var changes []Change
+
+	var rewrite = func(path string, fn func(string) string) error {
+		content, e := filesystem.ReadFile(path)
+		if errors.Is(e, fs.ErrNotExist) {
+			return nil
+		} else if e != nil {
+			return e
+		}
+
+		before := string(content)
+		if after := fn(before); after != before {
+			relative, _ := filepath.Rel(root, path)
+			changes = append(changes, Change{Path: relative, Before: before, After: after})
+		}
+
+		return nil
+	}
+
+	// Module path and import paths.
+	if e := rewrite(filepath.Join(root, "go.mod"), func(content string) string {
+		return modules.ReplaceAllString(content, "module "+options.Module)
+	}); e != nil {
+		return nil, e
+	}
+
+	// References to the module path, executable name and prefix across go sources, test scripts and golden files
+	// (imports, doc comments, expected output, etc.). The module path and name may be the same: the module path is
+	// only referenced when followed by one of the checkout's directories (e.g. "template-go-cli/internal").
+	entries, e := filesystem.ReadDir(root)
+	if e != nil {
+		return nil, e
+	}
+
+	var directories []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			directories = append(directories, regexp.QuoteMeta(entry.Name()))
+		}
+	}
+
+	var references = regexp.MustCompile(`\b` + regexp.QuoteMeta(current.Name) + `\b`)
+	if len(directories) > 0 {
+		references = regexp.MustCompile(`\b` + regexp.QuoteMeta(current.Module) + `/(?:` + strings.Join(directories, "|") + `)\b|` + references.String())
+	}
+
+	prefix := strings.ToUpper(strings.ReplaceAll(options.Name, "-", "_"))
+
+	e = system.WalkDir(filesystem, root, func(path string, entry fs.DirEntry, e error) error {
+		if e != nil {
+			return e
+		}
+
+		if entry.IsDir() {
+			switch entry.Name() {
+			case "vendor", ".git", "node_modules":
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		switch filepath.Ext(path) {
+		case ".go", ".txtar", ".golden":
+		default:
+			return nil
+		}
+
+		return rewrite(path, func(content string) string {
+			content = references.ReplaceAllStringFunc(content, func(match string) string {
+				if strings.HasPrefix(match, current.Module+"/") {
+					return options.Module + strings.TrimPrefix(match, current.Module)
+				}
+
+				return options.Name
+			})
+
+			if current.Prefix != "" {
+				content = strings.ReplaceAll(content, current.Prefix, prefix)
+			}
+
+			return content
+		})
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	// Constants; merge with the references rewrite, which covers their doc comments.
+	constants := filepath.Join(root, "internal", "constants", "constants.go")
+
+	changes = merge(filesystem, changes, root, constants, func(content string) string {
+		content = names.ReplaceAllString(content, fmt.Sprintf("${1}%q", options.Name))
+
+		return prefixes.ReplaceAllString(content, fmt.Sprintf("${1}%q", prefix))
+	})
+
+	// Goreleaser.
+	if e := rewrite(filepath.Join(root, ".goreleaser.yml"), func(content string) string {
+		if current.Project != "" {
+			if current.Owner != "" {
+				content = strings.ReplaceAll(content, fmt.Sprintf("%s/%s", current.Owner, current.Project), fmt.Sprintf("%s/%s", options.Owner, options.Name))
+			}
+
+			content = regexp.MustCompile(`\b`+regexp.QuoteMeta(current.Project)+`\b`).ReplaceAllString(content, options.Name)
+		}
+
+		return owners.ReplaceAllString(content, fmt.Sprintf("${1}%s", options.Owner))
+	}); e != nil {
+		return nil, e
+	}
+
+	// Makefile.
+	if e := rewrite(filepath.Join(root, "Makefile"), func(content string) string {
+		content = makefile.ReplaceAllString(content, fmt.Sprintf("${1}%s", options.Name))
+
+		if current.Owner != "" {
+			content = strings.ReplaceAll(content, fmt.Sprintf("%s/homebrew-taps", current.Owner), fmt.Sprintf("%s/homebrew-taps", options.Owner))
+		}
+
+		return content
+	}); e != nil {
+		return nil, e
+	}
+
+	// README placeholders.
+	if e := rewrite(filepath.Join(root, "README.md"), func(content string) string {
+		content = strings.ReplaceAll(content, fmt.Sprintf("%q", current.Name), fmt.Sprintf("%q", options.Name))
+
+		if current.Owner != "" {
+			content = strings.ReplaceAll(content, fmt.Sprintf("%s/homebrew-taps", current.Owner), fmt.Sprintf("%s/homebrew-taps", options.Owner))
+		}
+
+		return content
+	}); e != nil {
+		return nil, e
+	}
+
+	return changes, nil
+}
+
+// Apply writes every change to the checkout.
+func Apply(filesystem system.Filesystem, root string, changes []Change) error {
+	for _, change := range changes {
+		path := filepath.Join(root, change.Path)
+
+		information, e := filesystem.Stat(path)
+		if e != nil {
+			return e
+		}
+
+		if e := filesystem.WriteFile(path, []byte(change.After), information.Mode().Perm()); e != nil {
+			return fmt.Errorf("unable to write %q: %w", change.Path, e)
+		}
+	}
+
+	return nil
+}
+
+// merge applies fn on top of any pending change to path, or reads the file if it has no pending change.
+func merge(filesystem system.Filesystem, changes []Change, root, path string, fn func(string) string) []Change {
+	relative, _ := filepath.Rel(root, path)
+
+	for index, change := range changes {
+		if change.Path == relative {
+			changes[index].After = fn(change.After)
+
+			return changes
+		}
+	}
+
+	content, e := filesystem.ReadFile(path)
+	if e != nil {
+		return changes
+	}
+
+	if after := fn(string(content)); after != string(content) {
+		changes = append(changes, Change{Path: relative, Before: string(content), After: after})
+	}
+
+	return changes
+}
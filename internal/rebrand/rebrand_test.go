@@ -0,0 +1,142 @@
+package rebrand
+
+import (
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/system"
+)
+
+// checkout is the repository root, relative to the package's directory.
+var checkout = filepath.Join("..", "..")
+
+// replicate copies the checkout, excluding its git directory, to a temporary directory.
+func replicate(t *testing.T) string {
+	t.Helper()
+
+	target := t.TempDir()
+
+	e := filepath.WalkDir(checkout, func(path string, entry fs.DirEntry, e error) error {
+		if e != nil {
+			return e
+		}
+
+		relative, e := filepath.Rel(checkout, path)
+		if e != nil {
+			return e
+		}
+
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return os.MkdirAll(filepath.Join(target, relative), 0o755)
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		information, e := entry.Info()
+		if e != nil {
+			return e
+		}
+
+		content, e := os.ReadFile(path)
+		if e != nil {
+			return e
+		}
+
+		return os.WriteFile(filepath.Join(target, relative), content, information.Mode().Perm())
+	})
+	if e != nil {
+		t.Fatalf("unable to copy the checkout: %v", e)
+	}
+
+	return target
+}
+
+func TestPlan(t *testing.T) {
+	filesystem := system.Host().Filesystem
+
+	current, e := Detect(filesystem, checkout)
+	if e != nil {
+		t.Fatalf("unable to detect the checkout's brand: %v", e)
+	}
+
+	// The brand differs from the one applied by [TestRebrand], whose re-branded checkout runs this test, too.
+	changes, e := Plan(filesystem, checkout, Options{Module: "github.com/example/fork", Name: "fork", Owner: "example"})
+	if e != nil {
+		t.Fatalf("unable to plan: %v", e)
+	}
+
+	var references = regexp.MustCompile(`\b(` + regexp.QuoteMeta(current.Name) + `|` + regexp.QuoteMeta(current.Prefix) + `)\b`)
+
+	var planned = map[string]Change{}
+	for _, change := range changes {
+		planned[filepath.ToSlash(change.Path)] = change
+	}
+
+	for _, path := range []string{"internal/constants/constants.go", "internal/commands/example/testdata/missing-name.stderr.golden", "internal/script/testdata/version.txtar"} {
+		change, ok := planned[path]
+		if !ok {
+			t.Errorf("expected a change to %q", path)
+
+			continue
+		}
+
+		if match := references.FindString(change.After); match != "" {
+			t.Errorf("expected %q to no longer reference %q:\n%s", path, match, change.After)
+		}
+	}
+
+	if change, ok := planned["go.mod"]; !ok || !strings.Contains(change.After, "module github.com/example/fork\n") {
+		t.Errorf("unexpected go.mod:\n%s", change.After)
+	}
+
+	if _, e := Plan(filesystem, checkout, Options{Module: "github.com/example/fork", Name: "Fork"}); e == nil {
+		t.Error("expected an invalid name to be rejected")
+	}
+}
+
+// TestRebrand re-brands a copy of the checkout and runs its tests, which must pass as they do for the template. As
+// it vets and tests the entire copy, it only runs when opted into via "REBRAND_TEST=1" (e.g. "make test-rebrand").
+func TestRebrand(t *testing.T) {
+	if os.Getenv("REBRAND_TEST") != "1" {
+		t.Skip("skipping the re-branded checkout's tests; set REBRAND_TEST=1 to run them")
+	}
+
+	if _, e := exec.LookPath("go"); e != nil {
+		t.Skip("skipping the re-branded checkout's tests: go is unavailable")
+	}
+
+	root := replicate(t)
+
+	filesystem := system.Host().Filesystem
+
+	changes, e := Plan(filesystem, root, Options{Module: "github.com/example/tool", Name: "tool", Owner: "example"})
+	if e != nil {
+		t.Fatalf("unable to plan: %v", e)
+	}
+
+	if e := Apply(filesystem, root, changes); e != nil {
+		t.Fatalf("unable to apply: %v", e)
+	}
+
+	// The nested run opts out, so that it doesn't recurse into this test.
+	for _, args := range [][]string{{"vet", "./..."}, {"test", "-short", "./..."}} {
+		command := exec.Command("go", args...)
+		command.Dir = root
+		command.Env = append(os.Environ(), "REBRAND_TEST=")
+
+		if content, e := command.CombinedOutput(); e != nil {
+			t.Fatalf("\"go %s\" failed in the re-branded checkout: %v\n%s", strings.Join(args, " "), e, content)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package redact replaces sensitive values -- secret flag values, URL credentials and log attributes -- before
+// they're persisted (e.g. in crash reports and failure logs). See [Args] and [Attribute].
+package redact
@@ -0,0 +1,7 @@
+// Package reference generates reference documentation -- roff man pages, a Markdown reference and a JSON
+// command manifest -- from the cobra command tree, such that published documentation shares its source with
+// "--help" output.
+//
+// All generators operate on the [Manifest], a serializable snapshot of the command tree that excludes hidden,
+// deprecated, experimental and plugin commands.
+package reference
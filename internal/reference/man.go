@@ -0,0 +1,104 @@
+package reference
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Man renders the command's roff man page in the given manual section (typically "1").
+func Man(w io.Writer, command Command, section string, date time.Time) error {
+	var b strings.Builder
+
+	title := strings.ToUpper(command.Basename())
+
+	fmt.Fprintf(&b, ".TH %q %q %q %q %q\n", title, section, date.Format("Jan 2006"), command.Path, "User Commands")
+
+	fmt.Fprintf(&b, ".SH NAME\n%s \\- %s\n", escape(command.Basename()), escape(command.Short))
+	fmt.Fprintf(&b, ".SH SYNOPSIS\n\\fB%s\\fP\n", escape(command.Usage))
+
+	description := command.Long
+	if description == "" {
+		description = command.Short
+	}
+
+	fmt.Fprintf(&b, ".SH DESCRIPTION\n%s\n", paragraphs(description))
+
+	options(&b, "OPTIONS", command.Flags)
+	options(&b, "OPTIONS INHERITED FROM PARENT COMMANDS", command.Inherited)
+
+	if command.Example != "" {
+		fmt.Fprintf(&b, ".SH EXAMPLE\n.PP\n.RS\n.nf\n%s\n.fi\n.RE\n", escape(command.Example))
+	}
+
+	var related []string
+	if parent, found := strings.CutSuffix(command.Path, " "+command.Name); found {
+		related = append(related, fmt.Sprintf("\\fB%s(%s)\\fP", escape(strings.ReplaceAll(parent, " ", "-")), section))
+	}
+
+	for _, child := range command.Commands {
+		related = append(related, fmt.Sprintf("\\fB%s(%s)\\fP", escape(child.Basename()), section))
+	}
+
+	if len(related) > 0 {
+		fmt.Fprintf(&b, ".SH SEE ALSO\n%s\n", strings.Join(related, ", "))
+	}
+
+	_, e := io.WriteString(w, b.String())
+
+	return e
+}
+
+// options renders a flag section.
+func options(b *strings.Builder, heading string, flags []Flag) {
+	if len(flags) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, ".SH %s\n", heading)
+
+	for _, flag := range flags {
+		name := fmt.Sprintf("\\fB\\-\\-%s\\fP", escape(flag.Name))
+		if flag.Shorthand != "" {
+			name = fmt.Sprintf("\\fB\\-%s\\fP, %s", escape(flag.Shorthand), name)
+		}
+
+		usage := flag.Usage
+		if flag.Default != "" {
+			usage = fmt.Sprintf("%s (default %s)", usage, flag.Default)
+		}
+
+		if flag.Type != "bool" {
+			name = fmt.Sprintf("%s=\\fI%s\\fP", name, escape(flag.Type))
+		}
+
+		// Preserve multi-line usage (e.g. enumerated value descriptions) as explicit line breaks.
+		fmt.Fprintf(b, ".TP\n%s\n%s\n", name, strings.ReplaceAll(escape(usage), "\n", "\n.br\n"))
+	}
+}
+
+// paragraphs escapes text and separates blank-line delimited paragraphs with ".PP" requests.
+func paragraphs(text string) string {
+	var blocks []string
+	for _, block := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		blocks = append(blocks, escape(strings.TrimSpace(block)))
+	}
+
+	return strings.Join(blocks, "\n.PP\n")
+}
+
+// escape escapes roff control sequences: backslashes, hyphens, and lines beginning with a control character.
+func escape(text string) string {
+	text = strings.ReplaceAll(text, "\\", "\\e")
+	text = strings.ReplaceAll(text, "-", "\\-")
+
+	var lines = strings.Split(text, "\n")
+	for index, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[index] = "\\&" + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,138 @@
+package reference
+
+import (
+	"strings"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/plugins"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Flag represents a command-line flag.
+type Flag struct {
+	Name      string `json:"name" yaml:"name"`
+	Shorthand string `json:"shorthand,omitempty" yaml:"shorthand,omitempty"`
+	Type      string `json:"type" yaml:"type"`
+	Default   string `json:"default,omitempty" yaml:"default,omitempty"`
+	Usage     string `json:"usage" yaml:"usage"`
+}
+
+// Command represents a documented command and its available sub-commands.
+type Command struct {
+	Name      string    `json:"name" yaml:"name"`
+	Path      string    `json:"path" yaml:"path"`   // Path is the full command path (e.g. "template-go-cli plugin list").
+	Usage     string    `json:"usage" yaml:"usage"` // Usage is the command's use-line.
+	Short     string    `json:"short" yaml:"short"`
+	Long      string    `json:"long,omitempty" yaml:"long,omitempty"`
+	Example   string    `json:"example,omitempty" yaml:"example,omitempty"`
+	Aliases   []string  `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	Group     string    `json:"group,omitempty" yaml:"group,omitempty"` // Group is the title of the command's help-group on its parent.
+	Flags     []Flag    `json:"flags,omitempty" yaml:"flags,omitempty"`
+	Inherited []Flag    `json:"inherited,omitempty" yaml:"inherited,omitempty"` // Inherited are the persistent flags inherited from parent commands.
+	Commands  []Command `json:"commands,omitempty" yaml:"commands,omitempty"`
+}
+
+// Manifest snapshots the command tree rooted at root.
+func Manifest(root *cobra.Command) Command {
+	root.InitDefaultHelpFlag()
+
+	var command = Command{
+		Name:      root.Name(),
+		Path:      root.CommandPath(),
+		Usage:     root.UseLine(),
+		Short:     root.Short,
+		Long:      root.Long,
+		Example:   root.Example,
+		Aliases:   root.Aliases,
+		Group:     group(root),
+		Flags:     flags(root.NonInheritedFlags()),
+		Inherited: flags(root.InheritedFlags()),
+	}
+
+	for _, child := range Children(root) {
+		command.Commands = append(command.Commands, Manifest(child))
+	}
+
+	return command
+}
+
+// Children returns the command's documentable sub-commands: neither hidden, deprecated, experimental (as they're
+// only available when enabled; see [registry.Experimental]) nor plugins.
+func Children(command *cobra.Command) []*cobra.Command {
+	var children []*cobra.Command
+
+	for _, child := range command.Commands() {
+		if _, plugin := child.Annotations[plugins.Annotation]; plugin {
+			continue
+		}
+
+		if _, experimental := child.Annotations[registry.Annotation]; experimental {
+			continue
+		}
+
+		if !child.IsAvailableCommand() && !child.IsAdditionalHelpTopicCommand() {
+			continue
+		}
+
+		children = append(children, child)
+	}
+
+	return children
+}
+
+// Walk calls fn for the command and, depth-first, each of its sub-commands.
+func (c Command) Walk(fn func(Command) error) error {
+	if e := fn(c); e != nil {
+		return e
+	}
+
+	for _, child := range c.Commands {
+		if e := child.Walk(fn); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// Basename returns a file-system friendly name for the command (e.g. "template-go-cli-plugin-list").
+func (c Command) Basename() string {
+	return strings.ReplaceAll(c.Path, " ", "-")
+}
+
+// group returns the title of the command's help-group, if any.
+func group(command *cobra.Command) string {
+	if command.GroupID == "" || !command.HasParent() {
+		return ""
+	}
+
+	for _, g := range command.Parent().Groups() {
+		if g.ID == command.GroupID {
+			return g.Title
+		}
+	}
+
+	return ""
+}
+
+// flags converts the visible flags of a flag-set.
+func flags(set *pflag.FlagSet) []Flag {
+	var collection []Flag
+
+	set.VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden || flag.Deprecated != "" {
+			return
+		}
+
+		var f = Flag{Name: flag.Name, Shorthand: flag.Shorthand, Type: flag.Value.Type(), Usage: flag.Usage}
+		if flag.DefValue != "" && flag.DefValue != "[]" && flag.DefValue != "false" && flag.DefValue != "0s" {
+			f.Default = flag.DefValue
+		}
+
+		collection = append(collection, f)
+	})
+
+	return collection
+}
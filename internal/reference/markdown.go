@@ -0,0 +1,113 @@
+package reference
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Markdown renders the command's reference page. Sub-commands are listed under their help-group titles, and
+// linked relative to the page via [Command.Basename].
+func Markdown(w io.Writer, command Command) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# `%s`\n\n", command.Path)
+	fmt.Fprintf(&b, "%s\n\n", command.Short)
+
+	if command.Long != "" && command.Long != command.Short {
+		fmt.Fprintf(&b, "%s\n\n", command.Long)
+	}
+
+	fmt.Fprintf(&b, "## Usage\n\n```\n%s\n```\n\n", command.Usage)
+
+	if len(command.Aliases) > 0 {
+		fmt.Fprintf(&b, "**Aliases**: %s\n\n", strings.Join(command.Aliases, ", "))
+	}
+
+	if command.Example != "" {
+		fmt.Fprintf(&b, "## Examples\n\n```bash\n%s\n```\n\n", command.Example)
+	}
+
+	table(&b, "Flags", command.Flags)
+	table(&b, "Inherited Flags", command.Inherited)
+
+	if len(command.Commands) > 0 {
+		fmt.Fprintf(&b, "## Commands\n\n")
+
+		for _, title := range titles(command) {
+			heading := title
+			if heading == "" {
+				heading = "Additional Commands"
+			}
+
+			fmt.Fprintf(&b, "### %s\n\n", heading)
+
+			for _, child := range command.Commands {
+				if child.Group == title {
+					fmt.Fprintf(&b, "- [`%s`](./%s.md) - %s\n", child.Name, child.Basename(), child.Short)
+				}
+			}
+
+			b.WriteString("\n")
+		}
+	}
+
+	if parent, found := strings.CutSuffix(command.Path, " "+command.Name); found {
+		fmt.Fprintf(&b, "## See Also\n\n- [`%s`](./%s.md)\n", parent, strings.ReplaceAll(parent, " ", "-"))
+	}
+
+	_, e := io.WriteString(w, strings.TrimRight(b.String(), "\n")+"\n")
+
+	return e
+}
+
+// table renders a flag table section.
+func table(b *strings.Builder, heading string, flags []Flag) {
+	if len(flags) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "## %s\n\n| Flag | Type | Default | Description |\n| --- | --- | --- | --- |\n", heading)
+
+	for _, flag := range flags {
+		name := fmt.Sprintf("`--%s`", flag.Name)
+		if flag.Shorthand != "" {
+			name = fmt.Sprintf("`-%s`, %s", flag.Shorthand, name)
+		}
+
+		var value string
+		if flag.Default != "" {
+			value = fmt.Sprintf("`%s`", flag.Default)
+		}
+
+		fmt.Fprintf(b, "| %s | `%s` | %s | %s |\n", name, strings.ReplaceAll(flag.Type, "|", "\\|"), value, strings.ReplaceAll(strings.ReplaceAll(flag.Usage, "|", "\\|"), "\n", "<br>"))
+	}
+
+	b.WriteString("\n")
+}
+
+// titles returns the distinct group titles of the command's children, in order of first appearance, with the
+// ungrouped ("") title last.
+func titles(command Command) []string {
+	var ordered []string
+	var seen = map[string]bool{}
+	var ungrouped bool
+
+	for _, child := range command.Commands {
+		if child.Group == "" {
+			ungrouped = true
+			continue
+		}
+
+		if !seen[child.Group] {
+			seen[child.Group] = true
+			ordered = append(ordered, child.Group)
+		}
+	}
+
+	if ungrouped {
+		ordered = append(ordered, "")
+	}
+
+	return ordered
+}
@@ -0,0 +1,125 @@
+package reference_test
+
+import (
+	"bytes"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"template-go-cli/internal/commands/registry"
+	"template-go-cli/internal/harness"
+	"template-go-cli/internal/plugins"
+	"template-go-cli/internal/reference"
+	"template-go-cli/internal/types/output"
+
+	"github.com/spf13/cobra"
+)
+
+// tree returns a small, fixed command tree exercising groups, aliases, examples, local and inherited flags, and
+// every kind of undocumented command.
+func tree() *cobra.Command {
+	run := func(*cobra.Command, []string) {}
+
+	root := &cobra.Command{Use: "tool", Short: "A tool", Long: "A tool.\n\nIt has a second paragraph."}
+	root.PersistentFlags().StringP("output", "o", "json", "the output format")
+	root.AddGroup(&cobra.Group{ID: "core", Title: "Core Commands"})
+
+	alpha := &cobra.Command{Use: "alpha <name>", Short: "Run alpha", Aliases: []string{"a"}, GroupID: "core", Example: "  tool alpha value", Run: run}
+	alpha.Flags().Bool("force", false, "force the run")
+	alpha.Flags().Duration("wait", time.Minute, "wait for completion\nbefore returning")
+
+	beta := &cobra.Command{Use: "beta", Short: "Manage beta"}
+	beta.AddCommand(&cobra.Command{Use: "gamma", Short: "Run gamma", Run: run})
+
+	root.AddCommand(
+		alpha,
+		beta,
+		&cobra.Command{Use: "secret", Short: "A hidden command", Hidden: true, Run: run},
+		&cobra.Command{Use: "old", Short: "A deprecated command", Deprecated: "use alpha", Run: run},
+		&cobra.Command{Use: "lab", Short: "An experimental command", Annotations: map[string]string{registry.Annotation: "true"}, Run: run},
+		&cobra.Command{Use: "extension", Short: "A plugin", Annotations: map[string]string{plugins.Annotation: "/usr/bin/tool-extension"}, Run: run},
+	)
+
+	return root
+}
+
+// basenames returns the basenames of every command in the manifest.
+func basenames(manifest reference.Command) []string {
+	var names []string
+
+	_ = manifest.Walk(func(command reference.Command) error {
+		names = append(names, command.Basename())
+
+		return nil
+	})
+
+	return names
+}
+
+func TestManifest(t *testing.T) {
+	manifest := reference.Manifest(tree())
+
+	// Hidden, deprecated, experimental and plugin commands are excluded.
+	if expected := []string{"tool", "tool-alpha", "tool-beta", "tool-beta-gamma"}; !slices.Equal(basenames(manifest), expected) {
+		t.Fatalf("expected the documented commands %q, received %q", expected, basenames(manifest))
+	}
+
+	// As printed by the docs command.
+	buffer, e := output.Write(output.JSON, manifest)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	harness.Golden(t, "manifest.json", buffer.String())
+}
+
+func TestMan(t *testing.T) {
+	date := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	e := reference.Manifest(tree()).Walk(func(command reference.Command) error {
+		var buffer bytes.Buffer
+		if e := reference.Man(&buffer, command, "1", date); e != nil {
+			return e
+		}
+
+		harness.Golden(t, "man/"+command.Basename()+".1", buffer.String())
+
+		return nil
+	})
+
+	if e != nil {
+		t.Fatal(e)
+	}
+}
+
+// link matches a Markdown link's target.
+var link = regexp.MustCompile(`\]\(\./([^)]+)\.md\)`)
+
+func TestMarkdown(t *testing.T) {
+	manifest := reference.Manifest(tree())
+
+	names := basenames(manifest)
+
+	e := manifest.Walk(func(command reference.Command) error {
+		var buffer bytes.Buffer
+		if e := reference.Markdown(&buffer, command); e != nil {
+			return e
+		}
+
+		// Every cross-link targets another page of the tree.
+		for _, match := range link.FindAllStringSubmatch(buffer.String(), -1) {
+			if !slices.Contains(names, match[1]) {
+				t.Errorf("%q links to %q, which isn't a page of the tree %q", command.Basename(), match[1], names)
+			}
+		}
+
+		harness.Golden(t, "markdown/"+command.Basename()+".md", buffer.String())
+
+		return nil
+	})
+
+	if e != nil {
+		t.Fatal(e)
+	}
+}
@@ -0,0 +1,6 @@
+// Package result records a command's result datum -- the value its RunE renders via [output.Write] -- so that
+// in-process callers receive the value itself, rather than its rendering, and so that the command-line knows the
+// command produced structured output. See [With] and [Record].
+//
+// [output.Write]: template-go-cli/internal/types/output.Write
+package result
@@ -0,0 +1,47 @@
+package result
+
+import (
+	"context"
+	"sync"
+
+	"template-go-cli/internal/contextual"
+)
+
+// Recorder holds the most recently recorded datum.
+type Recorder struct {
+	mutex    sync.Mutex
+	value    any
+	recorded bool
+}
+
+// Value returns the recorded datum, and whether one was recorded.
+func (r *Recorder) Value() (any, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.value, r.recorded
+}
+
+// key is the context key used to store and retrieve the [Recorder].
+var key = contextual.New[*Recorder]("result", nil)
+
+// With returns a copy of ctx holding a new [Recorder], along with the recorder.
+func With(ctx context.Context) (context.Context, *Recorder) {
+	var recorder = &Recorder{}
+
+	return key.With(ctx, recorder), recorder
+}
+
+// Record stores v in ctx's [Recorder], replacing any previously recorded datum. It's a no-op if ctx doesn't hold
+// a recorder.
+func Record(ctx context.Context, v any) {
+	recorder, ok := key.Get(ctx)
+	if !ok || recorder == nil {
+		return
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.value, recorder.recorded = v, true
+}
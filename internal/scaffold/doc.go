@@ -0,0 +1,5 @@
+// Package scaffold generates new command packages in the repository's house style -- a doc.go, a command.go
+// modeled after the "example" command, and a command_test.go comparing the command's output against golden files
+// (see "internal/harness"), along with the golden files themselves -- and registers them by adding a blank import to
+// "internal/commands/imports.go".
+package scaffold
@@ -0,0 +1,273 @@
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"template-go-cli/internal/system"
+)
+
+//go:embed templates/*.tmpl templates/testdata/*.tmpl
+var templates embed.FS
+
+// files are the generated package's files, relative to its directory; each is rendered from the same-named template.
+var files = []string{
+	"doc.go",
+	"command.go",
+	"command_test.go",
+	"testdata/json.stdout.golden",
+	"testdata/json.stderr.golden",
+	"testdata/yaml.stdout.golden",
+	"testdata/yaml.stderr.golden",
+}
+
+// Options represents the generated command's configuration.
+type Options struct {
+	Name   string // Name is the command's name (e.g. "list-items").
+	Parent string // Parent is the optional, space-separated parent command path (e.g. "plugin").
+	Group  string // Group is the optional help-group identifier.
+	Title  string // Title is the help-group's title; defaults to "<Group> Commands".
+}
+
+// data represents the template's input.
+type data struct {
+	Options
+
+	Module  string // Module is the go module's path.
+	Package string // Package is the generated package's name.
+	Path    string // Path is the command path relative to the root command (e.g. "plugin list-items").
+
+	Args []string // Args are the command path's arguments (e.g. {"plugin", "list-items"}).
+}
+
+// File represents a generated file.
+type File struct {
+	Path    string `json:"path" yaml:"path"`
+	Content []byte `json:"-" yaml:"-"`
+}
+
+// name validates command names: lowercase, alphanumeric words delimited by hyphens.
+var name = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+// Generate renders the command package's files relative to the repository root. The files aren't written;
+// see [Write].
+func Generate(filesystem system.Filesystem, root string, options Options) ([]File, error) {
+	if !name.MatchString(options.Name) {
+		return nil, fmt.Errorf("invalid command name %q: must be lowercase, hyphen-delimited words", options.Name)
+	}
+
+	if pkg := strings.ReplaceAll(options.Name, "-", ""); token.IsKeyword(pkg) {
+		return nil, fmt.Errorf("invalid command name %q: its package name %q is a go keyword", options.Name, pkg)
+	}
+
+	for _, segment := range strings.Fields(options.Parent) {
+		if !name.MatchString(segment) || token.IsKeyword(strings.ReplaceAll(segment, "-", "")) {
+			return nil, fmt.Errorf("invalid parent command %q", options.Parent)
+		}
+	}
+
+	module, e := Module(filesystem, root)
+	if e != nil {
+		return nil, e
+	}
+
+	if options.Group != "" && options.Title == "" {
+		options.Title = fmt.Sprintf("%s Commands", title(options.Group))
+	}
+
+	var input = data{
+		Options: options,
+		Module:  module,
+		Package: strings.ReplaceAll(options.Name, "-", ""),
+		Path:    strings.TrimSpace(strings.Join([]string{options.Parent, options.Name}, " ")),
+		Args:    append(strings.Fields(options.Parent), options.Name),
+	}
+
+	directory := filepath.Join(append([]string{root, "internal", "commands"}, append(packages(options.Parent), input.Package)...)...)
+
+	var generated []File
+	for _, filename := range files {
+		content, e := render(filename+".tmpl", input)
+		if e != nil {
+			return nil, e
+		}
+
+		generated = append(generated, File{Path: filepath.Join(directory, filepath.FromSlash(filename)), Content: content})
+	}
+
+	return generated, nil
+}
+
+// Write writes the files -- failing if any already exist -- and registers the package's import path.
+func Write(filesystem system.Filesystem, root string, files []File) error {
+	for _, file := range files {
+		if _, e := filesystem.Stat(file.Path); e == nil {
+			return fmt.Errorf("%q: %w", file.Path, fs.ErrExist)
+		}
+	}
+
+	for _, file := range files {
+		if e := filesystem.MkdirAll(filepath.Dir(file.Path), 0o755); e != nil {
+			return e
+		}
+
+		if e := filesystem.WriteFile(file.Path, file.Content, 0o644); e != nil {
+			return e
+		}
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	module, e := Module(filesystem, root)
+	if e != nil {
+		return e
+	}
+
+	relative, e := filepath.Rel(root, filepath.Dir(files[0].Path))
+	if e != nil {
+		return e
+	}
+
+	return Register(filesystem, root, module+"/"+filepath.ToSlash(relative))
+}
+
+// Register adds a blank import of the package to "internal/commands/imports.go", keeping the imports sorted.
+// Already registered packages are ignored.
+func Register(filesystem system.Filesystem, root, path string) error {
+	filename := filepath.Join(root, "internal", "commands", "imports.go")
+
+	content, e := filesystem.ReadFile(filename)
+	if e != nil {
+		return fmt.Errorf("unable to read command imports: %w", e)
+	}
+
+	start := bytes.Index(content, []byte("import (\n"))
+	if start < 0 {
+		return fmt.Errorf("unable to locate import block in %q", filename)
+	}
+
+	start += len("import (\n")
+
+	end := bytes.Index(content[start:], []byte(")"))
+	if end < 0 {
+		return fmt.Errorf("unable to locate end of import block in %q", filename)
+	}
+
+	end += start
+
+	var lines []string
+	var scanner = bufio.NewScanner(bytes.NewReader(content[start:end]))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	line := fmt.Sprintf("_ %q", path)
+	for _, existing := range lines {
+		if existing == line {
+			return nil
+		}
+	}
+
+	lines = append(lines, line)
+
+	sort.Strings(lines)
+
+	var buffer bytes.Buffer
+
+	buffer.Write(content[:start])
+	for _, line := range lines {
+		buffer.WriteString("\t" + line + "\n")
+	}
+
+	buffer.Write(content[end:])
+
+	formatted, e := format.Source(buffer.Bytes())
+	if e != nil {
+		return fmt.Errorf("unable to format command imports: %w", e)
+	}
+
+	return filesystem.WriteFile(filename, formatted, 0o644)
+}
+
+// Module reads the go module path from the repository root's go.mod.
+func Module(filesystem system.Filesystem, root string) (string, error) {
+	content, e := filesystem.ReadFile(filepath.Join(root, "go.mod"))
+	if e != nil {
+		return "", fmt.Errorf("unable to read go.mod; is %q the repository root?: %w", root, e)
+	}
+
+	var scanner = bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if module, found := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); found {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+
+	return "", errors.New("go.mod is missing a module directive")
+}
+
+// render executes the named template, formatting the result as go source for go files.
+func render(name string, input data) ([]byte, error) {
+	t, e := template.ParseFS(templates, "templates/"+name)
+	if e != nil {
+		return nil, e
+	}
+
+	var buffer bytes.Buffer
+	if e := t.Execute(&buffer, input); e != nil {
+		return nil, fmt.Errorf("unable to render %q: %w", name, e)
+	}
+
+	if !strings.HasSuffix(name, ".go.tmpl") {
+		return buffer.Bytes(), nil
+	}
+
+	formatted, e := format.Source(buffer.Bytes())
+	if e != nil {
+		return nil, fmt.Errorf("unable to format %q: %w", name, e)
+	}
+
+	return formatted, nil
+}
+
+// packages converts a parent command path into package directory names.
+func packages(parent string) []string {
+	var directories []string
+	for _, segment := range strings.Fields(parent) {
+		directories = append(directories, strings.ReplaceAll(segment, "-", ""))
+	}
+
+	return directories
+}
+
+// title capitalizes each hyphen-delimited word (e.g. "data-sources" becomes "Data Sources").
+func title(v string) string {
+	var words = strings.Split(v, "-")
+	for index, word := range words {
+		if word == "" {
+			continue
+		}
+
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[index] = string(runes)
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,110 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/system"
+)
+
+// repository returns a temporary repository root with a go.mod declaring the "example.com/tool" module.
+func repository(t *testing.T) string {
+	root := t.TempDir()
+
+	if e := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/tool\n\ngo 1.24\n"), 0o644); e != nil {
+		t.Fatal(e)
+	}
+
+	return root
+}
+
+func TestGenerate(t *testing.T) {
+	root := repository(t)
+
+	files, e := Generate(system.Host().Filesystem, root, Options{Name: "list-items", Parent: "plugin", Group: "data-sources"})
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var contents = map[string]string{}
+	for _, file := range files {
+		relative, e := filepath.Rel(root, file.Path)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		contents[filepath.ToSlash(relative)] = string(file.Content)
+	}
+
+	directory := "internal/commands/plugin/listitems/"
+
+	expected := map[string][]string{
+		directory + "doc.go":                      {"package listitems", `"plugin list-items"`},
+		directory + "command.go":                  {`Parent: "plugin"`, `Group:  &cobra.Group{ID: "data-sources", Title: "Data Sources Commands"}`, `"example.com/tool/internal/logging"`},
+		directory + "command_test.go":             {"package listitems_test", `"example.com/tool/internal/harness"`, `[]string{"plugin", "list-items", "--output", "json"}`, "harness.Golden"},
+		directory + "testdata/json.stdout.golden": {"{\n    \"command\": \"list-items\"\n}\n"},
+		directory + "testdata/yaml.stdout.golden": {"command: list-items\n"},
+		directory + "testdata/json.stderr.golden": {""},
+		directory + "testdata/yaml.stderr.golden": {""},
+	}
+
+	if len(contents) != len(expected) {
+		t.Fatalf("expected %d files, received %d", len(expected), len(contents))
+	}
+
+	for path, fragments := range expected {
+		content, exists := contents[path]
+		if !exists {
+			t.Errorf("expected %q to be generated", path)
+
+			continue
+		}
+
+		for _, fragment := range fragments {
+			if !strings.Contains(content, fragment) {
+				t.Errorf("expected %q to contain %q:\n%s", path, fragment, content)
+			}
+		}
+	}
+}
+
+func TestGenerateQuoting(t *testing.T) {
+	files, e := Generate(system.Host().Filesystem, repository(t), Options{Name: "list", Group: `a"b\c`, Title: "`Quoted` \"Title\""})
+	if e != nil {
+		t.Fatalf("expected the group and title to be quoted, received %v", e)
+	}
+
+	for _, file := range files {
+		if filepath.Base(file.Path) == "command.go" && !strings.Contains(string(file.Content), `&cobra.Group{ID: "a\"b\\c", Title: "`+"`Quoted`"+` \"Title\""}`) {
+			t.Fatalf("unexpected group:\n%s", file.Content)
+		}
+	}
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	root := repository(t)
+
+	tests := []struct {
+		name    string
+		options Options
+		message string
+	}{
+		{name: "uppercase", options: Options{Name: "List"}, message: "must be lowercase"},
+		{name: "trailing-hyphen", options: Options{Name: "list-"}, message: "must be lowercase"},
+		{name: "keyword", options: Options{Name: "func"}, message: `package name "func" is a go keyword`},
+		{name: "hyphenated-keyword", options: Options{Name: "go-to"}, message: `package name "goto" is a go keyword`},
+		{name: "keyword-parent", options: Options{Name: "list", Parent: "plugin range"}, message: `invalid parent command "plugin range"`},
+		{name: "invalid-parent", options: Options{Name: "list", Parent: "Plugin"}, message: `invalid parent command "Plugin"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := Generate(system.Host().Filesystem, root, test.options)
+			if e == nil || !strings.Contains(e.Error(), test.message) {
+				t.Fatalf("expected an error containing %q, received %v", test.message, e)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Package script runs end-to-end behavior specifications written as txtar archives, in the style of
+// "testscript". An archive's comment is the script; its files are extracted into the script's working directory
+// ($WORK) before the script runs. Each line is a command, optionally prefixed with "!" to expect failure:
+//
+//	exec <program> [args...]  run a program; the cli itself (by name) runs via [harness.Run]
+//	stdin <file>              use the file's content as the next exec's standard-input
+//	stdout <regexp>           assert the last exec's standard-output matches the (multi-line) expression
+//	stderr <regexp>           assert the last exec's standard-error matches the (multi-line) expression
+//	env <key>=<value>         set an environment variable for subsequent execs
+//	cmp <a> <b>               assert two files are identical; "stdout" and "stderr" name the last exec's output
+//	exists <path>             assert a file exists
+//	cd <directory>            change the working directory
+//
+// Blank lines and lines beginning with "#" are ignored. Arguments are separated by whitespace; single quotes
+// preserve whitespace (two single quotes escape one). "$NAME" and "${NAME}" expand to the script's environment,
+// which includes WORK, HOME and MODULE (the cli's go module path).
+package script
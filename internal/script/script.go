@@ -0,0 +1,362 @@
+package script
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/diff"
+	"template-go-cli/internal/harness"
+)
+
+// Run runs every "*.txtar" script within directory as a sub-test, named after the script's file.
+func Run(t *testing.T, directory string) {
+	t.Helper()
+
+	scripts, e := filepath.Glob(filepath.Join(directory, "*.txtar"))
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if len(scripts) == 0 {
+		t.Fatalf("no scripts found within %q", directory)
+	}
+
+	for _, path := range scripts {
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".txtar"), func(t *testing.T) {
+			content, e := os.ReadFile(path)
+			if e != nil {
+				t.Fatal(e)
+			}
+
+			execute(t, path, Parse(content))
+		})
+	}
+}
+
+// state represents a running script.
+type state struct {
+	t *testing.T
+
+	work string            // work is the script's root working directory ($WORK).
+	cwd  string            // cwd is the current working directory.
+	env  map[string]string // env is the script's environment, passed to every exec.
+
+	stdin          string
+	stdout, stderr string
+}
+
+// execute runs the archive's script within a new working directory.
+func execute(t *testing.T, path string, archive *Archive) {
+	work := t.TempDir()
+
+	if e := archive.Extract(work); e != nil {
+		t.Fatal(e)
+	}
+
+	home := filepath.Join(work, ".home")
+	if e := os.MkdirAll(home, 0o755); e != nil {
+		t.Fatal(e)
+	}
+
+	var s = &state{t: t, work: work, cwd: work, env: map[string]string{"WORK": work, "HOME": home, "MODULE": module()}}
+
+	t.Chdir(work)
+
+	for index, line := range strings.Split(string(archive.Comment), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		t.Logf("> %s", line)
+
+		if e := s.command(line); e != nil {
+			t.Fatalf("%s:%d: %s", filepath.Base(path), index+1, e)
+		}
+	}
+}
+
+// module returns the cli's go module path, as reported by the (test) executable's build information.
+func module() string {
+	if information, available := debug.ReadBuildInfo(); available {
+		return information.Main.Path
+	}
+
+	return ""
+}
+
+// command runs a single script line.
+func (s *state) command(line string) error {
+	negated := strings.HasPrefix(line, "!")
+	if negated {
+		line = strings.TrimSpace(line[1:])
+	}
+
+	args, e := s.fields(line)
+	if e != nil {
+		return e
+	}
+
+	if len(args) == 0 {
+		return errors.New("missing command")
+	}
+
+	name, args := args[0], args[1:]
+
+	switch name {
+	case "exec":
+		return s.exec(negated, args)
+	case "stdout":
+		return s.match(negated, "stdout", s.stdout, args)
+	case "stderr":
+		return s.match(negated, "stderr", s.stderr, args)
+	}
+
+	if negated {
+		return fmt.Errorf("%q doesn't support negation", name)
+	}
+
+	switch name {
+	case "stdin":
+		if len(args) != 1 {
+			return errors.New("usage: stdin <file>")
+		}
+
+		content, e := os.ReadFile(s.path(args[0]))
+		if e != nil {
+			return e
+		}
+
+		s.stdin = string(content)
+	case "env":
+		for _, pair := range args {
+			key, value, found := strings.Cut(pair, "=")
+			if !found {
+				return errors.New("usage: env <key>=<value>...")
+			}
+
+			s.env[key] = value
+		}
+	case "cmp":
+		if len(args) != 2 {
+			return errors.New("usage: cmp <a> <b>")
+		}
+
+		a, e := s.read(args[0])
+		if e != nil {
+			return e
+		}
+
+		b, e := s.read(args[1])
+		if e != nil {
+			return e
+		}
+
+		if a != b {
+			return fmt.Errorf("%s and %s differ:\n%s", args[0], args[1], diff.Unified(args[0]+" → "+args[1], a, b))
+		}
+	case "exists":
+		for _, path := range args {
+			if _, e := os.Stat(s.path(path)); e != nil {
+				return e
+			}
+		}
+	case "cd":
+		if len(args) != 1 {
+			return errors.New("usage: cd <directory>")
+		}
+
+		directory := s.path(args[0])
+
+		if information, e := os.Stat(directory); e != nil {
+			return e
+		} else if !information.IsDir() {
+			return fmt.Errorf("%q is not a directory", args[0])
+		}
+
+		s.cwd = directory
+
+		s.t.Chdir(directory)
+	default:
+		return fmt.Errorf("unknown command %q", name)
+	}
+
+	return nil
+}
+
+// exec runs the cli via [harness.Run], or any other program as a sub-process.
+func (s *state) exec(negated bool, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: exec <program> [args...]")
+	}
+
+	var code int
+
+	if args[0] == constants.Name {
+		result := harness.Run(s.t, harness.Options{Args: args[1:], Env: s.env, Stdin: s.stdin, Home: s.env["HOME"]})
+
+		s.stdout, s.stderr, code = result.Stdout, result.Stderr, result.Code
+	} else {
+		var stdout, stderr bytes.Buffer
+
+		command := exec.Command(args[0], args[1:]...)
+		command.Dir = s.cwd
+		command.Stdin = strings.NewReader(s.stdin)
+		command.Stdout, command.Stderr = &stdout, &stderr
+		command.Env = os.Environ()
+
+		for key, value := range s.env {
+			command.Env = append(command.Env, key+"="+value)
+		}
+
+		e := command.Run()
+
+		var exit *exec.ExitError
+		if errors.As(e, &exit) {
+			code = exit.ExitCode()
+		} else if e != nil {
+			return e
+		}
+
+		s.stdout, s.stderr = stdout.String(), stderr.String()
+	}
+
+	s.stdin = ""
+
+	if s.stdout != "" {
+		s.t.Logf("[stdout]\n%s", s.stdout)
+	}
+
+	if s.stderr != "" {
+		s.t.Logf("[stderr]\n%s", s.stderr)
+	}
+
+	switch {
+	case negated && code == 0:
+		return errors.New("unexpected command success")
+	case !negated && code != 0:
+		return fmt.Errorf("unexpected command failure: exit code %d", code)
+	}
+
+	return nil
+}
+
+// match asserts the output does (or, if negated, doesn't) match the expression.
+func (s *state) match(negated bool, name, output string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: %s <regexp>", name)
+	}
+
+	expression, e := regexp.Compile("(?m)" + args[0])
+	if e != nil {
+		return e
+	}
+
+	matched := expression.MatchString(output)
+
+	switch {
+	case negated && matched:
+		return fmt.Errorf("unexpected match for %q in %s", args[0], name)
+	case !negated && !matched:
+		return fmt.Errorf("no match for %q in %s", args[0], name)
+	}
+
+	return nil
+}
+
+// read returns a file's content; "stdout" and "stderr" name the last exec's output.
+func (s *state) read(name string) (string, error) {
+	switch name {
+	case "stdout":
+		return s.stdout, nil
+	case "stderr":
+		return s.stderr, nil
+	}
+
+	content, e := os.ReadFile(s.path(name))
+
+	return string(content), e
+}
+
+// path resolves a path relative to the current working directory.
+func (s *state) path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+
+	return filepath.Join(s.cwd, name)
+}
+
+// fields splits a line into arguments, honoring single quotes and expanding environment variables outside them.
+func (s *state) fields(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quoted, started bool
+
+	expand := func(v string) string {
+		return os.Expand(v, func(key string) string {
+			return s.env[key]
+		})
+	}
+
+	var pending strings.Builder // pending holds unquoted text awaiting expansion.
+
+	flush := func() {
+		current.WriteString(expand(pending.String()))
+		pending.Reset()
+	}
+
+	for index := 0; index < len(line); index++ {
+		character := line[index]
+
+		switch {
+		case quoted && character == '\'':
+			if index+1 < len(line) && line[index+1] == '\'' {
+				current.WriteByte('\'')
+				index++
+
+				continue
+			}
+
+			quoted = false
+		case quoted:
+			current.WriteByte(character)
+		case character == '\'':
+			flush()
+
+			quoted, started = true, true
+		case character == ' ' || character == '\t':
+			flush()
+
+			if started {
+				args = append(args, current.String())
+				current.Reset()
+				started = false
+			}
+		default:
+			pending.WriteByte(character)
+			started = true
+		}
+	}
+
+	if quoted {
+		return nil, errors.New("unterminated quote")
+	}
+
+	flush()
+
+	if started {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
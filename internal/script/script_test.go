@@ -0,0 +1,11 @@
+package script_test
+
+import (
+	"testing"
+
+	"template-go-cli/internal/script"
+)
+
+func TestScripts(t *testing.T) {
+	script.Run(t, "testdata")
+}
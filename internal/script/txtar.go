@@ -0,0 +1,99 @@
+package script
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// File is a single file within an [Archive].
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Archive is a parsed txtar archive: a free-form comment followed by a sequence of files, each introduced by a
+// "-- name --" marker line.
+type Archive struct {
+	Comment []byte
+	Files   []File
+}
+
+// Parse parses txtar content. Every file's data, and the comment, ends with a newline unless empty.
+func Parse(content []byte) *Archive {
+	var archive = &Archive{}
+
+	var current *File
+	var builder strings.Builder
+
+	commit := func() {
+		data := []byte(builder.String())
+		builder.Reset()
+
+		if current == nil {
+			archive.Comment = data
+		} else {
+			current.Data = data
+			archive.Files = append(archive.Files, *current)
+		}
+	}
+
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+
+		if name, ok := marker(line); ok {
+			commit()
+			current = &File{Name: name}
+
+			continue
+		}
+
+		builder.WriteString(line)
+	}
+
+	commit()
+
+	return archive
+}
+
+// marker reports whether line is a file marker ("-- name --"), returning the file's name.
+func marker(line string) (string, bool) {
+	line = strings.TrimRight(line, "\n")
+	if !strings.HasPrefix(line, "-- ") || !strings.HasSuffix(line, " --") || len(line) < len("-- x --") {
+		return "", false
+	}
+
+	name := strings.TrimSpace(line[3 : len(line)-3])
+
+	return name, name != ""
+}
+
+// Extract writes the archive's files beneath directory. File names must be relative and may not escape directory.
+func (a *Archive) Extract(directory string) error {
+	for _, file := range a.Files {
+		name := filepath.FromSlash(file.Name)
+		if filepath.IsAbs(name) || !filepath.IsLocal(name) {
+			return fmt.Errorf("invalid archive file name %q", file.Name)
+		}
+
+		path := filepath.Join(directory, name)
+
+		if e := os.MkdirAll(filepath.Dir(path), 0o755); e != nil {
+			return e
+		}
+
+		if e := os.WriteFile(path, file.Data, 0o644); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
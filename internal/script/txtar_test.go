@@ -0,0 +1,34 @@
+package script
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	archive := Parse([]byte("comment\n-- a.txt --\nalpha\n--  b/c.txt  --\nbeta\n\n-- not -- a marker\n-- empty --\n-- last --\nno trailing newline"))
+
+	if string(archive.Comment) != "comment\n" {
+		t.Errorf("unexpected comment %q", archive.Comment)
+	}
+
+	want := []File{
+		{Name: "a.txt", Data: []byte("alpha\n")},
+		{Name: "b/c.txt", Data: []byte("beta\n\n-- not -- a marker\n")},
+		{Name: "empty", Data: []byte("")},
+		{Name: "last", Data: []byte("no trailing newline\n")},
+	}
+
+	if len(archive.Files) != len(want) {
+		t.Fatalf("expected %d files, received %d", len(want), len(archive.Files))
+	}
+
+	for index, file := range archive.Files {
+		if file.Name != want[index].Name || string(file.Data) != string(want[index].Data) {
+			t.Errorf("expected %q: %q, received %q: %q", want[index].Name, want[index].Data, file.Name, file.Data)
+		}
+	}
+
+	if e := (&Archive{Files: []File{{Name: "../escape"}}}).Extract(t.TempDir()); e == nil {
+		t.Error("expected an error for a file escaping the directory")
+	}
+}
@@ -0,0 +1,72 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Increment represents a version component to increment.
+type Increment string
+
+const (
+	Major      Increment = "major"
+	Minor      Increment = "minor"
+	Patch      Increment = "patch"
+	Prerelease Increment = "pre"
+)
+
+// Bump returns the version incremented by the given component; build metadata is always discarded.
+//
+//   - A major, minor or patch increment of a pre-release version releases it, if the pre-release already targets
+//     that component (e.g. a patch increment of "1.2.3-rc.1" yields "1.2.3", and a minor increment of
+//     "1.3.0-rc.1" yields "1.3.0"); otherwise the component is incremented as usual.
+//   - A pre-release increment with identifier id increments the trailing numeric identifier of a matching
+//     pre-release ("1.2.3-rc.1" yields "1.2.3-rc.2"), restarts a differing pre-release ("1.2.3-alpha.4" yields
+//     "1.2.3-rc.1"), and otherwise increments the patch version of a release ("1.2.3" yields "1.2.4-rc.1").
+func (v Version) Bump(increment Increment, id string) (Version, error) {
+	var next = Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+
+	switch increment {
+	case Major:
+		if !v.Prerelease() || v.Minor != 0 || v.Patch != 0 {
+			next.Major, next.Minor, next.Patch = v.Major+1, 0, 0
+		}
+	case Minor:
+		if !v.Prerelease() || v.Patch != 0 {
+			next.Minor, next.Patch = v.Minor+1, 0
+		}
+	case Patch:
+		if !v.Prerelease() {
+			next.Patch = v.Patch + 1
+		}
+	case Prerelease:
+		if id == "" {
+			id = "rc"
+		}
+
+		if !identifier.MatchString(id) {
+			return Version{}, fmt.Errorf("invalid pre-release identifier %q", id)
+		}
+
+		switch {
+		case !v.Prerelease():
+			next.Patch = v.Patch + 1
+			next.Pre = []string{id, "1"}
+		case v.Pre[0] == id:
+			next.Pre = append([]string{}, v.Pre...)
+
+			last := len(next.Pre) - 1
+			if n, e := strconv.ParseUint(next.Pre[last], 10, 64); e == nil && last > 0 {
+				next.Pre[last] = strconv.FormatUint(n+1, 10)
+			} else {
+				next.Pre = append(next.Pre, "1")
+			}
+		default:
+			next.Pre = []string{id, "1"}
+		}
+	default:
+		return Version{}, fmt.Errorf("invalid increment %q: must be one of major, minor, patch or pre", increment)
+	}
+
+	return next, nil
+}
@@ -0,0 +1,275 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// comparator represents a single "<op><version>" condition.
+type comparator struct {
+	operator string
+	version  Version
+}
+
+// satisfied reports whether v satisfies the comparator.
+func (c comparator) satisfied(v Version) bool {
+	comparison := v.Compare(c.version)
+
+	switch c.operator {
+	case "=":
+		return comparison == 0
+	case "!=":
+		return comparison != 0
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	}
+
+	return false
+}
+
+// Constraint represents a set of version requirements (e.g. ">=1.2.0, <2.0.0 || ^3.1").
+//
+//   - Comparators ("=", "!=", ">", ">=", "<", "<=") are separated by commas or whitespace, all of which must hold.
+//   - Alternatives are separated by "||", any of which may hold.
+//   - Caret ranges permit changes that don't modify the left-most non-zero component ("^1.2.3" is ">=1.2.3, <2.0.0").
+//   - Tilde ranges permit patch-level changes ("~1.2.3" is ">=1.2.3, <1.3.0").
+//   - Partial versions and wildcards ("1.2", "1.x", "*") match any omitted component.
+//
+// As with npm, a pre-release version only satisfies a set of comparators if one of them references a pre-release
+// of the same major, minor and patch version.
+type Constraint struct {
+	raw          string
+	alternatives [][]comparator
+}
+
+// partial matches a version whose minor and patch components may be omitted or wildcards.
+var partial = regexp.MustCompile(`^v?(\d+|[xX*])(?:\.(\d+|[xX*]))?(?:\.(\d+|[xX*]))?(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// operator matches a comparator's leading operator.
+var operator = regexp.MustCompile(`^(\^|~|!=|>=|<=|>|<|=)?\s*`)
+
+// ParseConstraint parses a version constraint; see [Constraint].
+func ParseConstraint(v string) (Constraint, error) {
+	var constraint = Constraint{raw: strings.TrimSpace(v)}
+
+	if constraint.raw == "" {
+		return Constraint{}, fmt.Errorf("invalid version constraint: empty")
+	}
+
+	for _, alternative := range strings.Split(constraint.raw, "||") {
+		var comparators []comparator
+
+		// Join operators separated from their version by whitespace (e.g. ">= 1.2.3").
+		tokens := join(strings.FieldsFunc(alternative, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		}))
+
+		if len(tokens) == 0 {
+			return Constraint{}, fmt.Errorf("invalid version constraint %q: empty alternative", v)
+		}
+
+		for _, token := range tokens {
+			expanded, e := expand(token)
+			if e != nil {
+				return Constraint{}, fmt.Errorf("invalid version constraint %q: %w", v, e)
+			}
+
+			comparators = append(comparators, expanded...)
+		}
+
+		constraint.alternatives = append(constraint.alternatives, comparators)
+	}
+
+	return constraint, nil
+}
+
+// MustParseConstraint is like [ParseConstraint] but panics if the constraint cannot be parsed.
+func MustParseConstraint(v string) Constraint {
+	constraint, e := ParseConstraint(v)
+	if e != nil {
+		panic(e)
+	}
+
+	return constraint
+}
+
+// String returns the constraint as it was parsed.
+func (c Constraint) String() string {
+	return c.raw
+}
+
+// Check reports whether v satisfies the constraint.
+func (c Constraint) Check(v Version) bool {
+	for _, comparators := range c.alternatives {
+		satisfied := true
+		for _, comparator := range comparators {
+			if !comparator.satisfied(v) {
+				satisfied = false
+				break
+			}
+		}
+
+		if satisfied && (!v.Prerelease() || permits(comparators, v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// permits reports whether a comparator references a pre-release of v's major, minor and patch version.
+func permits(comparators []comparator, v Version) bool {
+	for _, comparator := range comparators {
+		if candidate := comparator.version; candidate.Prerelease() && candidate.Major == v.Major && candidate.Minor == v.Minor && candidate.Patch == v.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// join merges standalone operator tokens with the subsequent version token.
+func join(tokens []string) []string {
+	var joined []string
+
+	for index := 0; index < len(tokens); index++ {
+		token := tokens[index]
+		if operator.FindString(token) == token && index+1 < len(tokens) {
+			token += tokens[index+1]
+			index++
+		}
+
+		joined = append(joined, token)
+	}
+
+	return joined
+}
+
+// expand converts a single (possibly partial, caret or tilde) comparator into primitive comparators.
+func expand(token string) ([]comparator, error) {
+	prefix := operator.FindString(token)
+	op := strings.TrimSpace(prefix)
+
+	match := partial.FindStringSubmatch(token[len(prefix):])
+	if match == nil {
+		return nil, fmt.Errorf("invalid comparator %q", token)
+	}
+
+	// Resolve the specified components; a wildcard or omission truncates the version.
+	var components []uint64
+	for _, component := range match[1:4] {
+		if component == "" || strings.ContainsAny(component, "xX*") {
+			break
+		}
+
+		value, e := strconv.ParseUint(component, 10, 64)
+		if e != nil {
+			return nil, fmt.Errorf("invalid comparator %q: %w", token, e)
+		}
+
+		components = append(components, value)
+	}
+
+	var base Version
+	for index, value := range components {
+		switch index {
+		case 0:
+			base.Major = value
+		case 1:
+			base.Minor = value
+		case 2:
+			base.Patch = value
+		}
+	}
+
+	if match[4] != "" {
+		if len(components) < 3 {
+			return nil, fmt.Errorf("invalid comparator %q: pre-release requires a full version", token)
+		}
+
+		version, e := Parse(fmt.Sprintf("%d.%d.%d%s", base.Major, base.Minor, base.Patch, match[4]))
+		if e != nil {
+			return nil, e
+		}
+
+		base = version
+	}
+
+	// upper returns the exclusive upper bound when incrementing the component at index.
+	upper := func(index int) Version {
+		switch index {
+		case 0:
+			return Version{Major: base.Major + 1, Pre: []string{"0"}}
+		case 1:
+			return Version{Major: base.Major, Minor: base.Minor + 1, Pre: []string{"0"}}
+		default:
+			return Version{Major: base.Major, Minor: base.Minor, Patch: base.Patch + 1, Pre: []string{"0"}}
+		}
+	}
+
+	switch op {
+	case "^":
+		if len(components) == 0 {
+			return []comparator{{">=", Version{}}}, nil
+		}
+
+		// Increment the left-most non-zero specified component, or the last specified component.
+		index := len(components) - 1
+		for position, value := range components {
+			if value != 0 {
+				index = position
+				break
+			}
+		}
+
+		return []comparator{{">=", base}, {"<", upper(index)}}, nil
+	case "~":
+		if len(components) == 0 {
+			return []comparator{{">=", Version{}}}, nil
+		}
+
+		return []comparator{{">=", base}, {"<", upper(min(len(components)-1, 1))}}, nil
+	}
+
+	if len(components) == 3 {
+		if op == "" {
+			op = "="
+		}
+
+		return []comparator{{op, base}}, nil
+	}
+
+	// Partial versions represent the range of every version they match.
+	if len(components) == 0 {
+		if op == "<" || op == "!=" {
+			return []comparator{{"<", Version{Pre: []string{"0"}}}}, nil
+		}
+
+		return []comparator{{">=", Version{}}}, nil
+	}
+
+	last := len(components) - 1
+
+	switch op {
+	case "", "=":
+		return []comparator{{">=", base}, {"<", upper(last)}}, nil
+	case "!=":
+		return nil, fmt.Errorf("invalid comparator %q: \"!=\" requires a full version", token)
+	case ">":
+		return []comparator{{">=", upper(last)}}, nil
+	case ">=":
+		return []comparator{{">=", base}}, nil
+	case "<":
+		return []comparator{{"<", base}}, nil
+	default: // "<="
+		return []comparator{{"<", upper(last)}}, nil
+	}
+}
@@ -0,0 +1,92 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestConstraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		satisfied   []string
+		unsatisfied []string
+	}{
+		// Comparators.
+		{constraint: "1.2.3", satisfied: []string{"1.2.3", "1.2.3+build"}, unsatisfied: []string{"1.2.4", "1.2.2"}},
+		{constraint: "=v1.2.3", satisfied: []string{"1.2.3"}, unsatisfied: []string{"1.2.4"}},
+		{constraint: "!=1.2.3", satisfied: []string{"1.2.4", "0.0.1"}, unsatisfied: []string{"1.2.3"}},
+		{constraint: ">1.2.3", satisfied: []string{"1.2.4", "2.0.0"}, unsatisfied: []string{"1.2.3", "1.0.0"}},
+		{constraint: ">=1.2.3", satisfied: []string{"1.2.3", "1.3.0"}, unsatisfied: []string{"1.2.2"}},
+		{constraint: "<1.2.3", satisfied: []string{"1.2.2", "0.9.0"}, unsatisfied: []string{"1.2.3"}},
+		{constraint: "<=1.2.3", satisfied: []string{"1.2.3", "1.0.0"}, unsatisfied: []string{"1.2.4"}},
+
+		// Conjunctions, separated by commas or whitespace, and with operators separated from their version.
+		{constraint: ">=1.2.0, <2.0.0", satisfied: []string{"1.2.0", "1.9.9"}, unsatisfied: []string{"1.1.9", "2.0.0"}},
+		{constraint: ">= 1.2.0 < 2.0.0", satisfied: []string{"1.5.0"}, unsatisfied: []string{"2.0.0"}},
+
+		// Alternatives.
+		{constraint: "<1.0.0 || >=2.0.0", satisfied: []string{"0.9.0", "2.1.0"}, unsatisfied: []string{"1.0.0", "1.9.9"}},
+		{constraint: ">=1.2.0, <2.0.0 || ^3.1", satisfied: []string{"1.2.0", "3.1.0", "3.9.0"}, unsatisfied: []string{"2.5.0", "3.0.9", "4.0.0"}},
+
+		// Caret ranges.
+		{constraint: "^1.2.3", satisfied: []string{"1.2.3", "1.9.0"}, unsatisfied: []string{"1.2.2", "2.0.0", "2.0.0-rc.1"}},
+		{constraint: "^0.2.3", satisfied: []string{"0.2.3", "0.2.9"}, unsatisfied: []string{"0.3.0", "0.2.2"}},
+		{constraint: "^0.0.3", satisfied: []string{"0.0.3"}, unsatisfied: []string{"0.0.4"}},
+		{constraint: "^1.2", satisfied: []string{"1.2.0", "1.9.9"}, unsatisfied: []string{"1.1.9", "2.0.0"}},
+		{constraint: "^0.0", satisfied: []string{"0.0.0", "0.0.9"}, unsatisfied: []string{"0.1.0"}},
+		{constraint: "^*", satisfied: []string{"0.0.0", "9.9.9"}},
+
+		// Tilde ranges.
+		{constraint: "~1.2.3", satisfied: []string{"1.2.3", "1.2.9"}, unsatisfied: []string{"1.3.0", "1.2.2"}},
+		{constraint: "~1.2", satisfied: []string{"1.2.0", "1.2.9"}, unsatisfied: []string{"1.3.0"}},
+		{constraint: "~1", satisfied: []string{"1.0.0", "1.9.9"}, unsatisfied: []string{"2.0.0"}},
+
+		// Partial versions and wildcards.
+		{constraint: "1.2", satisfied: []string{"1.2.0", "1.2.9"}, unsatisfied: []string{"1.3.0", "1.1.0"}},
+		{constraint: "1.x", satisfied: []string{"1.0.0", "1.9.9"}, unsatisfied: []string{"2.0.0", "0.9.9"}},
+		{constraint: "1.2.*", satisfied: []string{"1.2.0", "1.2.9"}, unsatisfied: []string{"1.3.0"}},
+		{constraint: "*", satisfied: []string{"0.0.0", "9.9.9"}, unsatisfied: []string{"1.0.0-rc.1"}},
+		{constraint: ">1.2", satisfied: []string{"1.3.0"}, unsatisfied: []string{"1.2.9"}},
+		{constraint: ">=1.2", satisfied: []string{"1.2.0"}, unsatisfied: []string{"1.1.9"}},
+		{constraint: "<1.2", satisfied: []string{"1.1.9"}, unsatisfied: []string{"1.2.0"}},
+		{constraint: "<=1.2", satisfied: []string{"1.2.9"}, unsatisfied: []string{"1.3.0"}},
+		{constraint: "<*", unsatisfied: []string{"0.0.0", "1.0.0"}},
+
+		// Pre-releases only satisfy comparators referencing a pre-release of the same version.
+		{constraint: ">=1.2.3-rc.1", satisfied: []string{"1.2.3-rc.1", "1.2.3-rc.2", "1.2.3", "1.3.0"}, unsatisfied: []string{"1.2.3-beta.1", "1.3.0-rc.1"}},
+		{constraint: ">=1.0.0", unsatisfied: []string{"1.2.3-rc.1"}},
+		{constraint: "^1.2.3-rc.1", satisfied: []string{"1.2.3-rc.1", "1.5.0"}, unsatisfied: []string{"1.5.0-rc.1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.constraint, func(t *testing.T) {
+			constraint, e := ParseConstraint(test.constraint)
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if constraint.String() != test.constraint {
+				t.Errorf("expected %q, received %q", test.constraint, constraint.String())
+			}
+
+			for _, version := range test.satisfied {
+				if !constraint.Check(MustParse(version)) {
+					t.Errorf("expected %s to satisfy %q", version, test.constraint)
+				}
+			}
+
+			for _, version := range test.unsatisfied {
+				if constraint.Check(MustParse(version)) {
+					t.Errorf("expected %s not to satisfy %q", version, test.constraint)
+				}
+			}
+		})
+	}
+}
+
+func TestParseConstraintInvalid(t *testing.T) {
+	for _, constraint := range []string{"", " ", "1.2.3 ||", "|| 1.2.3", "a.b.c", ">=>1.2.3", "1.2-rc.1", "!=1.2", "1.2.3.4", "99999999999999999999"} {
+		if _, e := ParseConstraint(constraint); e == nil {
+			t.Errorf("expected %q to be rejected", constraint)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+// Package semver parses, compares and increments [Semantic Versioning 2.0.0] versions, including pre-release and
+// build metadata identifiers.
+//
+// [Semantic Versioning 2.0.0]: https://semver.org/spec/v2.0.0.html
+package semver
@@ -0,0 +1,149 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version represents a semantic version.
+type Version struct {
+	Major, Minor, Patch uint64
+
+	Pre   []string // Pre are the dot-separated pre-release identifiers (e.g. ["rc", "1"]).
+	Build []string // Build are the dot-separated build metadata identifiers.
+}
+
+// expression is the official semver 2.0.0 regular expression, extended with an optional "v" prefix.
+var expression = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// identifier validates a single pre-release identifier.
+var identifier = regexp.MustCompile(`^(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)$`)
+
+// Parse parses a semantic version; a leading "v" is permitted.
+func Parse(v string) (Version, error) {
+	match := expression.FindStringSubmatch(strings.TrimSpace(v))
+	if match == nil {
+		return Version{}, fmt.Errorf("invalid semantic version %q", v)
+	}
+
+	var version Version
+	var e error
+
+	if version.Major, e = strconv.ParseUint(match[1], 10, 64); e != nil {
+		return Version{}, fmt.Errorf("invalid major version %q: %w", match[1], e)
+	}
+
+	if version.Minor, e = strconv.ParseUint(match[2], 10, 64); e != nil {
+		return Version{}, fmt.Errorf("invalid minor version %q: %w", match[2], e)
+	}
+
+	if version.Patch, e = strconv.ParseUint(match[3], 10, 64); e != nil {
+		return Version{}, fmt.Errorf("invalid patch version %q: %w", match[3], e)
+	}
+
+	if match[4] != "" {
+		version.Pre = strings.Split(match[4], ".")
+	}
+
+	if match[5] != "" {
+		version.Build = strings.Split(match[5], ".")
+	}
+
+	return version, nil
+}
+
+// MustParse is like [Parse] but panics if the version cannot be parsed.
+func MustParse(v string) Version {
+	version, e := Parse(v)
+	if e != nil {
+		panic(e)
+	}
+
+	return version
+}
+
+// String returns the version's canonical representation, without a "v" prefix.
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+
+	if len(v.Pre) > 0 {
+		s += "-" + strings.Join(v.Pre, ".")
+	}
+
+	if len(v.Build) > 0 {
+		s += "+" + strings.Join(v.Build, ".")
+	}
+
+	return s
+}
+
+// Prerelease reports whether the version has pre-release identifiers.
+func (v Version) Prerelease() bool {
+	return len(v.Pre) > 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether v has lower, equal or higher precedence than other. Build
+// metadata is ignored, per the specification.
+func (v Version) Compare(other Version) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	// A version without pre-release identifiers has higher precedence.
+	switch {
+	case len(v.Pre) == 0 && len(other.Pre) == 0:
+		return 0
+	case len(v.Pre) == 0:
+		return 1
+	case len(other.Pre) == 0:
+		return -1
+	}
+
+	for index := 0; index < len(v.Pre) && index < len(other.Pre); index++ {
+		if c := compare(v.Pre[index], other.Pre[index]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(v.Pre) < len(other.Pre):
+		return -1
+	case len(v.Pre) > len(other.Pre):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compare compares pre-release identifiers: numeric identifiers compare numerically and have lower precedence
+// than alphanumeric identifiers, which compare lexically.
+func compare(a, b string) int {
+	x, xe := strconv.ParseUint(a, 10, 64)
+	y, ye := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case xe == nil && ye == nil:
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	case xe == nil:
+		return -1
+	case ye == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
@@ -0,0 +1,174 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Version
+		valid    bool
+	}{
+		{input: "1.2.3", expected: Version{Major: 1, Minor: 2, Patch: 3}, valid: true},
+		{input: "v1.2.3", expected: Version{Major: 1, Minor: 2, Patch: 3}, valid: true},
+		{input: " 0.0.13\n", expected: Version{Patch: 13}, valid: true},
+		{input: "1.2.3-rc.1", expected: Version{Major: 1, Minor: 2, Patch: 3, Pre: []string{"rc", "1"}}, valid: true},
+		{input: "1.2.3-alpha-beta.0", expected: Version{Major: 1, Minor: 2, Patch: 3, Pre: []string{"alpha-beta", "0"}}, valid: true},
+		{input: "1.2.3+build.5", expected: Version{Major: 1, Minor: 2, Patch: 3, Build: []string{"build", "5"}}, valid: true},
+		{input: "1.2.3-rc.1+sha.abc", expected: Version{Major: 1, Minor: 2, Patch: 3, Pre: []string{"rc", "1"}, Build: []string{"sha", "abc"}}, valid: true},
+		{input: ""},
+		{input: "1.2"},
+		{input: "1.2.3.4"},
+		{input: "01.2.3"},
+		{input: "1.2.3-01"},
+		{input: "1.2.3-rc..1"},
+		{input: "1.2.3+"},
+		{input: "V1.2.3"},
+		{input: "99999999999999999999.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			version, e := Parse(test.input)
+
+			if !test.valid {
+				if e == nil {
+					t.Fatalf("expected an error; received %s", version)
+				}
+
+				return
+			}
+
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if version.Major != test.expected.Major || version.Minor != test.expected.Minor || version.Patch != test.expected.Patch || !slices.Equal(version.Pre, test.expected.Pre) || !slices.Equal(version.Build, test.expected.Build) {
+				t.Fatalf("expected %#v, received %#v", test.expected, version)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, input := range []string{"1.2.3", "0.0.0", "1.2.3-rc.1", "1.2.3+build", "1.2.3-rc.1+sha.abc"} {
+		if v := MustParse("v" + input).String(); v != input {
+			t.Errorf("expected %q, received %q", input, v)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1.2.3", b: "1.2.3", expected: 0},
+		{a: "1.2.3", b: "1.2.4", expected: -1},
+		{a: "1.3.0", b: "1.2.9", expected: 1},
+		{a: "2.0.0", b: "1.99.99", expected: 1},
+		{a: "1.10.0", b: "1.9.0", expected: 1},
+		{a: "1.2.3+a", b: "1.2.3+b", expected: 0},
+		{a: "1.2.3-rc.1", b: "1.2.3", expected: -1},
+		{a: "1.2.3", b: "1.2.3-rc.1", expected: 1},
+		{a: "1.2.3-rc.1", b: "1.2.2", expected: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			if c := MustParse(test.a).Compare(MustParse(test.b)); c != test.expected {
+				t.Fatalf("expected %d, received %d", test.expected, c)
+			}
+		})
+	}
+}
+
+// TestPrecedence verifies the specification's example ordering of pre-release versions.
+func TestPrecedence(t *testing.T) {
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+
+	for index := 1; index < len(ordered); index++ {
+		lower, higher := MustParse(ordered[index-1]), MustParse(ordered[index])
+
+		if c := lower.Compare(higher); c != -1 {
+			t.Errorf("expected %s < %s; received %d", lower, higher, c)
+		}
+
+		if c := higher.Compare(lower); c != 1 {
+			t.Errorf("expected %s > %s; received %d", higher, lower, c)
+		}
+	}
+}
+
+func TestBump(t *testing.T) {
+	tests := []struct {
+		version   string
+		increment Increment
+		id        string
+		expected  string
+	}{
+		{version: "1.2.3", increment: Major, expected: "2.0.0"},
+		{version: "1.2.3", increment: Minor, expected: "1.3.0"},
+		{version: "1.2.3", increment: Patch, expected: "1.2.4"},
+		{version: "1.2.3+build", increment: Patch, expected: "1.2.4"},
+
+		// Releasing a pre-release that targets the component.
+		{version: "2.0.0-rc.1", increment: Major, expected: "2.0.0"},
+		{version: "1.3.0-rc.1", increment: Minor, expected: "1.3.0"},
+		{version: "1.2.3-rc.1", increment: Patch, expected: "1.2.3"},
+
+		// Incrementing past a pre-release that doesn't target the component.
+		{version: "1.2.3-rc.1", increment: Major, expected: "2.0.0"},
+		{version: "1.2.3-rc.1", increment: Minor, expected: "1.3.0"},
+
+		// Pre-releases.
+		{version: "1.2.3", increment: Prerelease, expected: "1.2.4-rc.1"},
+		{version: "1.2.3", increment: Prerelease, id: "alpha", expected: "1.2.4-alpha.1"},
+		{version: "1.2.3-rc.1", increment: Prerelease, expected: "1.2.3-rc.2"},
+		{version: "1.2.3-rc.9+build", increment: Prerelease, id: "rc", expected: "1.2.3-rc.10"},
+		{version: "1.2.3-rc", increment: Prerelease, expected: "1.2.3-rc.1"},
+		{version: "1.2.3-alpha.4", increment: Prerelease, id: "rc", expected: "1.2.3-rc.1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version+" "+string(test.increment)+" "+test.id, func(t *testing.T) {
+			previous := MustParse(test.version)
+
+			next, e := previous.Bump(test.increment, test.id)
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if next.String() != test.expected {
+				t.Fatalf("expected %q, received %q", test.expected, next)
+			}
+
+			if next.Compare(previous) != 1 {
+				t.Fatalf("expected %s to have higher precedence than %s", next, previous)
+			}
+		})
+	}
+}
+
+func TestBumpInvalid(t *testing.T) {
+	version := MustParse("1.2.3")
+
+	if _, e := version.Bump("minimal", ""); e == nil {
+		t.Error("expected an invalid increment to be rejected")
+	}
+
+	if _, e := version.Bump(Prerelease, "r.c"); e == nil {
+		t.Error("expected an invalid pre-release identifier to be rejected")
+	}
+}
@@ -0,0 +1,4 @@
+// Package session exposes an execution's controls -- re-entering the command tree and detaching from its signal
+// handling -- to the commands it executes, via their [context.Context]. Commands can't otherwise reach them, as the
+// commands package imports every command package.
+package session
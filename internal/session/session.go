@@ -0,0 +1,32 @@
+package session
+
+import (
+	"context"
+
+	"template-go-cli/internal/contextual"
+)
+
+// Controls are an execution's controls.
+type Controls struct {
+	// Run executes the root command with args, nested within the execution, and returns the exit code. The nested
+	// execution is bound to ctx, and is independently cancelled upon SIGINT or SIGTERM.
+	Run func(ctx context.Context, args []string) int
+
+	// Detach stops SIGINT and SIGTERM from cancelling the execution, so that a long-lived command (e.g. an
+	// interactive shell) may handle signals itself.
+	Detach func()
+}
+
+// key is the context key used to store and retrieve the execution's [Controls].
+var key = contextual.New[Controls]("session", nil)
+
+// With returns a copy of ctx holding the execution's controls.
+func With(ctx context.Context, controls Controls) context.Context {
+	return key.With(ctx, controls)
+}
+
+// Get returns the execution's controls held by ctx, and whether they were set (i.e. the command is executing via
+// the root command, rather than having had its RunE called directly).
+func Get(ctx context.Context) (Controls, bool) {
+	return key.Get(ctx)
+}
@@ -0,0 +1,4 @@
+// Package system abstracts a command's access to the host: its environment variables, filesystem and clock. The
+// abstraction is carried by the command's [context.Context], so that an embedding program or test may substitute
+// any of them; see [With].
+package system
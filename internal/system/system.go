@@ -0,0 +1,129 @@
+package system
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"template-go-cli/internal/contextual"
+)
+
+// Filesystem is the subset of filesystem operations available to commands.
+type Filesystem interface {
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+	ReadDir(name string) ([]fs.DirEntry, error)
+	Stat(name string) (fs.FileInfo, error)
+	MkdirAll(path string, perm fs.FileMode) error
+	Chmod(name string, mode fs.FileMode) error
+	Rename(from, to string) error
+	Remove(name string) error
+}
+
+// System represents the host a command executes on. A nil field falls back to the host's own; see [Host].
+type System struct {
+	// Env is the environment, in [os.Environ]'s "key=value" form; later duplicates take precedence. A nil Env is
+	// the process' own environment.
+	Env []string
+
+	Filesystem Filesystem       // Filesystem is the filesystem, as [os.ReadFile], et al.
+	Clock      func() time.Time // Clock returns the current time, as [time.Now].
+}
+
+// host implements [Filesystem] via the [os] package.
+type host struct{}
+
+func (host) ReadFile(name string) ([]byte, error) { return os.ReadFile(name) }
+
+func (host) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
+func (host) ReadDir(name string) ([]fs.DirEntry, error) { return os.ReadDir(name) }
+
+func (host) Stat(name string) (fs.FileInfo, error) { return os.Stat(name) }
+
+func (host) MkdirAll(path string, perm fs.FileMode) error { return os.MkdirAll(path, perm) }
+
+func (host) Chmod(name string, mode fs.FileMode) error { return os.Chmod(name, mode) }
+
+func (host) Rename(from, to string) error { return os.Rename(from, to) }
+
+func (host) Remove(name string) error { return os.Remove(name) }
+
+// Host returns the process' actual environment, filesystem and clock.
+func Host() System {
+	return System{Filesystem: host{}, Clock: time.Now}
+}
+
+// key is the context key used to store and retrieve the [System]; absent a value, it defaults to [Host].
+var key = contextual.New("system", Host)
+
+// With returns a copy of ctx holding s. Any of s's nil fields are populated from [Host].
+func With(ctx context.Context, s System) context.Context {
+	fallback := Host()
+
+	if s.Filesystem == nil {
+		s.Filesystem = fallback.Filesystem
+	}
+
+	if s.Clock == nil {
+		s.Clock = fallback.Clock
+	}
+
+	return key.With(ctx, s)
+}
+
+// Get returns the [System] held by ctx, or [Host] if one wasn't set.
+func Get(ctx context.Context) System {
+	return key.Value(ctx)
+}
+
+// LookupEnv retrieves the environment variable named by key, and whether it's set.
+func (s System) LookupEnv(key string) (string, bool) {
+	if s.Env == nil {
+		return os.LookupEnv(key)
+	}
+
+	for index := len(s.Env) - 1; index >= 0; index-- {
+		if k, v, found := strings.Cut(s.Env[index], "="); found && k == key {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// Getenv retrieves the environment variable named by key; it's empty if unset.
+func (s System) Getenv(key string) string {
+	v, _ := s.LookupEnv(key)
+
+	return v
+}
+
+// Environ returns a copy of the environment, in "key=value" form (e.g. for a sub-process).
+func (s System) Environ() []string {
+	if s.Env == nil {
+		return os.Environ()
+	}
+
+	return append([]string(nil), s.Env...)
+}
+
+// Now returns the current time.
+func (s System) Now() time.Time {
+	return s.Clock()
+}
+
+// Environment returns a [System.Env] holding the given variables; unlike the process' environment, keys absent from
+// the map are unset.
+func Environment(variables map[string]string) []string {
+	var environment = make([]string, 0, len(variables))
+	for key, value := range variables {
+		environment = append(environment, key+"="+value)
+	}
+
+	return environment
+}
@@ -0,0 +1,64 @@
+package system
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// WalkDir walks the file tree rooted at root, as [filepath.WalkDir], via the filesystem.
+func WalkDir(filesystem Filesystem, root string, fn fs.WalkDirFunc) error {
+	information, e := filesystem.Stat(root)
+	if e != nil {
+		e = fn(root, nil, e)
+	} else {
+		e = walk(filesystem, root, fs.FileInfoToDirEntry(information), fn)
+	}
+
+	if errors.Is(e, filepath.SkipDir) || errors.Is(e, filepath.SkipAll) {
+		return nil
+	}
+
+	return e
+}
+
+// walk recursively descends path, calling fn for it and each of its entries in lexical order.
+func walk(filesystem Filesystem, path string, entry fs.DirEntry, fn fs.WalkDirFunc) error {
+	if e := fn(path, entry, nil); e != nil || !entry.IsDir() {
+		if errors.Is(e, filepath.SkipDir) && entry.IsDir() {
+			e = nil
+		}
+
+		return e
+	}
+
+	entries, e := filesystem.ReadDir(path)
+	if e != nil {
+		// Report the error once more, allowing fn to skip the directory.
+		if e = fn(path, entry, e); e != nil {
+			if errors.Is(e, filepath.SkipDir) && entry.IsDir() {
+				e = nil
+			}
+
+			return e
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	for _, child := range entries {
+		if e := walk(filesystem, filepath.Join(path, child.Name()), child, fn); e != nil {
+			if errors.Is(e, filepath.SkipDir) {
+				break
+			}
+
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package terminal provides terminal capability detection and raw-mode input.
+package terminal
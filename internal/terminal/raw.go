@@ -0,0 +1,13 @@
+package terminal
+
+import (
+	"os"
+)
+
+// Raw puts the terminal attached to file into raw mode -- input is read byte-by-byte, without echo, line buffering
+// or signal generation -- and returns a function restoring its previous state. An error is returned if the file
+// isn't a terminal or raw mode is unsupported on the platform (see [errors.ErrUnsupported]); callers are expected to
+// fall back to line-buffered input.
+func Raw(file *os.File) (restore func() error, e error) {
+	return raw(file.Fd())
+}
@@ -0,0 +1,18 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package terminal
+
+import (
+	"errors"
+)
+
+// attributed reports whether the file descriptor has terminal attributes; they can't be queried on the platform, so
+// [Interactive] relies on the character device check alone.
+func attributed(fd uintptr) bool {
+	return true
+}
+
+// raw implements [Raw]; raw mode is unsupported on the platform.
+func raw(fd uintptr) (func() error, error) {
+	return nil, errors.ErrUnsupported
+}
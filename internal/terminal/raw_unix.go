@@ -0,0 +1,47 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package terminal
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ioctl performs a termios ioctl request against the file descriptor.
+func ioctl(fd uintptr, request uintptr, state *syscall.Termios) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(unsafe.Pointer(state))); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// attributed reports whether the file descriptor has termios attributes, i.e. is a terminal; see isatty(3).
+func attributed(fd uintptr) bool {
+	var state syscall.Termios
+
+	return ioctl(fd, get, &state) == nil
+}
+
+// raw implements [Raw] via termios, akin to cfmakeraw(3) but retaining output post-processing.
+func raw(fd uintptr) (func() error, error) {
+	var previous syscall.Termios
+	if e := ioctl(fd, get, &previous); e != nil {
+		return nil, e
+	}
+
+	state := previous
+
+	state.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	state.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	state.Cflag &^= syscall.CSIZE | syscall.PARENB
+	state.Cflag |= syscall.CS8
+	state.Cc[syscall.VMIN] = 1
+	state.Cc[syscall.VTIME] = 0
+
+	if e := ioctl(fd, set, &state); e != nil {
+		return nil, e
+	}
+
+	return func() error { return ioctl(fd, set, &previous) }, nil
+}
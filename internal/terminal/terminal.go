@@ -0,0 +1,35 @@
+package terminal
+
+import (
+	"context"
+	"os"
+
+	"template-go-cli/internal/system"
+)
+
+// Interactive reports whether the file is attached to a terminal: a character device with terminal attributes
+// (unlike, e.g., [os.DevNull]), where the platform supports querying them.
+func Interactive(file *os.File) bool {
+	information, e := file.Stat()
+	if e != nil {
+		return false
+	}
+
+	return information.Mode()&os.ModeCharDevice != 0 && attributed(file.Fd())
+}
+
+// Color reports whether colored output is appropriate for the file: the file must be interactive, and the context's
+// environment (see [system.Get]) must neither set "NO_COLOR" nor a "dumb" "TERM".
+func Color(ctx context.Context, file *os.File) bool {
+	var s = system.Get(ctx)
+
+	if _, set := s.LookupEnv("NO_COLOR"); set {
+		return false
+	}
+
+	if s.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	return Interactive(file)
+}
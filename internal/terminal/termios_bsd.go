@@ -0,0 +1,13 @@
+//go:build darwin || freebsd || netbsd || openbsd || dragonfly
+
+package terminal
+
+import (
+	"syscall"
+)
+
+// The termios ioctl requests; see tcgetattr(3) and tcsetattr(3).
+const (
+	get = syscall.TIOCGETA
+	set = syscall.TIOCSETA
+)
@@ -0,0 +1,11 @@
+package terminal
+
+import (
+	"syscall"
+)
+
+// The termios ioctl requests; see tcgetattr(3) and tcsetattr(3).
+const (
+	get = syscall.TCGETS
+	set = syscall.TCSETS
+)
@@ -1,10 +1,11 @@
 package level
 
 import (
-	"errors"
 	"log/slog"
-	"strings"
 
+	"template-go-cli/internal/flags"
+
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
@@ -24,26 +25,38 @@ const (
 	Error   Type = "error"
 )
 
+// Enumeration defines every valid [Type], in order of increasing severity, with descriptions and aliases.
+var Enumeration = flags.Enumeration[Type]{
+	{Value: Trace, Description: "tracing of the program's execution"},
+	{Value: Debug, Description: "contextual information for debugging"},
+	{Value: Info, Description: "general operational messages"},
+	{Value: Notice, Description: "conditions that aren't errors but might need handling"},
+	{Value: Warning, Description: "warning conditions", Aliases: []string{"warn"}},
+	{Value: Error, Description: "error conditions", Aliases: []string{"err"}},
+}
+
+// Types lists every valid [Type], in order of increasing severity.
+var Types = Enumeration.Values()
+
+// Completion is a [cobra.CompletionFunc] providing the valid [Types], with descriptions, for shell completion.
+func Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return Enumeration.Completion(cmd, args, toComplete)
+}
+
 // String is used both by fmt.Print and by Cobra in help text
 func (o *Type) String() string {
 	return string(*o)
 }
 
-// Set must have pointer receiver so it doesn't change the value of a copy
+// Set must have pointer receiver so it doesn't change the value of a copy. Matching is case-insensitive; the
+// canonical value is stored.
 func (o *Type) Set(v string) error {
-	switch strings.ToLower(v) {
-	case "trace", "debug", "info", "notice", "warning", "error":
-		*o = Type(v)
-
-		return nil
-	default:
-		return errors.New("must be one of \"trace\", \"debug\", \"info\", \"notice\", \"warning\", \"error\"")
-	}
+	return Enumeration.Set(o, v)
 }
 
 // Type is only used in help text
 func (o *Type) Type() string {
-	return "(trace|debug|info|notice|warning|error)"
+	return Enumeration.Type()
 }
 
 // Level - Exported constants representing [slog.Level].
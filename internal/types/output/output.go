@@ -3,10 +3,12 @@ package output
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
 
+	"template-go-cli/internal/flags"
+
 	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
@@ -21,25 +23,34 @@ const (
 	YAML Type = "yaml"
 )
 
+// Enumeration defines every valid [Type], with descriptions and aliases.
+var Enumeration = flags.Enumeration[Type]{
+	{Value: JSON, Description: "JSON, indented with four spaces"},
+	{Value: YAML, Description: "YAML, indented with four spaces", Aliases: []string{"yml"}},
+}
+
+// Types lists every valid [Type].
+var Types = Enumeration.Values()
+
+// Completion is a [cobra.CompletionFunc] providing the valid [Types], with descriptions, for shell completion.
+func Completion(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return Enumeration.Completion(cmd, args, toComplete)
+}
+
 // String is used both by fmt.Print and by Cobra in help text.
 func (o *Type) String() string {
 	return string(*o)
 }
 
-// Set must have pointer receiver so it doesn't change the value of a copy.
+// Set must have pointer receiver so it doesn't change the value of a copy. Matching is case-insensitive; the
+// canonical value is stored.
 func (o *Type) Set(v string) error {
-	switch v {
-	case "json", "yaml":
-		*o = Type(v)
-		return nil
-	default:
-		return errors.New("must be one of \"json\" or \"yaml\"")
-	}
+	return Enumeration.Set(o, v)
 }
 
 // Type is only used in help text.
 func (o *Type) Type() string {
-	return "(yaml|json)"
+	return Enumeration.Type()
 }
 
 // Write serializes the provided datum into the specified format (JSON or YAML) and writes it to the given [io.Writer].
@@ -0,0 +1,73 @@
+package update
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"template-go-cli/internal/constants"
+)
+
+// Extract returns the executable's content from a release archive (".tar.gz" or ".zip").
+func Extract(name string, archive []byte) ([]byte, error) {
+	var executables = []string{constants.Name, constants.Name + ".exe"}
+
+	matches := func(entry string) bool {
+		base := path.Base(entry)
+		return base == executables[0] || base == executables[1]
+	}
+
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		reader, e := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if e != nil {
+			return nil, e
+		}
+
+		for _, file := range reader.File {
+			if !file.FileInfo().Mode().IsRegular() || !matches(file.Name) {
+				continue
+			}
+
+			handle, e := file.Open()
+			if e != nil {
+				return nil, e
+			}
+
+			defer handle.Close()
+
+			return read(handle)
+		}
+	case strings.HasSuffix(name, ".tar.gz"):
+		decompressor, e := gzip.NewReader(bytes.NewReader(archive))
+		if e != nil {
+			return nil, e
+		}
+
+		defer decompressor.Close()
+
+		reader := tar.NewReader(decompressor)
+		for {
+			header, e := reader.Next()
+			if errors.Is(e, io.EOF) {
+				break
+			} else if e != nil {
+				return nil, e
+			}
+
+			if header.Typeflag == tar.TypeReg && matches(header.Name) {
+				return read(reader)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported archive format %q", path.Base(name))
+	}
+
+	return nil, fmt.Errorf("archive %q doesn't contain the %q executable", path.Base(name), constants.Name)
+}
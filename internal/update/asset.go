@@ -0,0 +1,68 @@
+package update
+
+import (
+	"fmt"
+	"path"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strings"
+
+	"template-go-cli/internal/constants"
+)
+
+// Asset returns the release archive name for the given platform, mirroring ".goreleaser.yml"'s archive
+// "name_template" (e.g. "template-go-cli-linux-x86-64.tar.gz"). The goarm parameter is only applicable to "arm".
+func Asset(goos, goarch, goarm string) string {
+	var architecture = goarch
+	switch goarch {
+	case "amd64":
+		architecture = "x86-64"
+	case "386":
+		architecture = "i386"
+	}
+
+	if goarch == "arm" && goarm != "" {
+		architecture += "v" + goarm
+	}
+
+	var extension = ".tar.gz"
+	if goos == "windows" {
+		extension = ".zip"
+	}
+
+	return fmt.Sprintf("%s-%s-%s%s", constants.Name, goos, architecture, extension)
+}
+
+// Select returns the manifest's asset for the running platform.
+func (m *Manifest) Select() (string, error) {
+	name := Asset(runtime.GOOS, runtime.GOARCH, arm())
+
+	index := slices.IndexFunc(m.Assets, func(asset string) bool {
+		return path.Base(asset) == name
+	})
+
+	if index < 0 {
+		return "", fmt.Errorf("release %s has no asset %q for %s/%s", m.Version, name, runtime.GOOS, runtime.GOARCH)
+	}
+
+	return m.Assets[index], nil
+}
+
+// arm returns the "GOARM" build setting of the running executable (e.g. "7"), if any.
+func arm() string {
+	information, valid := debug.ReadBuildInfo()
+	if !valid {
+		return ""
+	}
+
+	for _, setting := range information.Settings {
+		if setting.Key == "GOARM" {
+			version, _, _ := strings.Cut(setting.Value, ",")
+
+			return version
+		}
+	}
+
+	return ""
+}
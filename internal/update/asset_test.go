@@ -0,0 +1,45 @@
+package update
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/constants"
+)
+
+func TestAsset(t *testing.T) {
+	tests := []struct {
+		goos, goarch, goarm string
+		expected            string
+	}{
+		{goos: "linux", goarch: "amd64", expected: constants.Name + "-linux-x86-64.tar.gz"},
+		{goos: "linux", goarch: "386", expected: constants.Name + "-linux-i386.tar.gz"},
+		{goos: "linux", goarch: "arm", goarm: "7", expected: constants.Name + "-linux-armv7.tar.gz"},
+		{goos: "darwin", goarch: "arm64", expected: constants.Name + "-darwin-arm64.tar.gz"},
+		{goos: "windows", goarch: "amd64", expected: constants.Name + "-windows-x86-64.zip"},
+	}
+
+	for _, test := range tests {
+		if actual := Asset(test.goos, test.goarch, test.goarm); actual != test.expected {
+			t.Errorf("expected %q, received %q", test.expected, actual)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	name := Asset(runtime.GOOS, runtime.GOARCH, arm())
+
+	manifest := &Manifest{Version: "1.2.3", Assets: []string{"other.tar.gz", "https://example.com/releases/" + name}}
+
+	asset, e := manifest.Select()
+	if e != nil || asset != "https://example.com/releases/"+name {
+		t.Fatalf("unexpected asset %q: %v", asset, e)
+	}
+
+	manifest.Assets = manifest.Assets[:1]
+
+	if _, e := manifest.Select(); e == nil || !strings.Contains(e.Error(), "has no asset") {
+		t.Fatalf("expected a missing asset error, received %v", e)
+	}
+}
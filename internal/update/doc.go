@@ -0,0 +1,15 @@
+// Package update implements the cli's self-update mechanism: reading a release [Manifest] from a feed (a local
+// directory or an HTTP(S) location), selecting the goreleaser archive for the running platform, verifying its
+// SHA-256 checksum and the release's ed25519 signature, and atomically replacing the running executable.
+//
+// A feed is a location containing the following files:
+//
+//   - "manifest.json": the [Manifest], naming the release's version, assets, checksum file and signature file.
+//   - The checksum file (e.g. "checksums.txt"): goreleaser's "sha256sum"-formatted checksums of each asset.
+//   - The signature file (e.g. "checksums.txt.sig"): the base64-encoded ed25519 signature of the manifest's version
+//     and the checksum file; see [Payload] and [Sign].
+//   - The release archives, named per ".goreleaser.yml" (e.g. "template-go-cli-linux-x86-64.tar.gz").
+//
+// Paths within the manifest are resolved relative to the feed, unless absolute URLs; a local feed's paths must not
+// escape its directory. The verifying public key is embedded at build time (see "-X main.key=...").
+package update
@@ -0,0 +1,142 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+)
+
+// Limit is the maximum size, in bytes, of any file read from a feed.
+const Limit = 512 << 20
+
+// Manifest describes a feed's release.
+type Manifest struct {
+	Version   string   `json:"version" yaml:"version"`
+	Assets    []string `json:"assets" yaml:"assets"`       // Assets are the release archives' names (or URLs).
+	Checksums string   `json:"checksums" yaml:"checksums"` // Checksums is the checksum file's name (or URL).
+	Signature string   `json:"signature" yaml:"signature"` // Signature is the checksum file's signature file name (or URL).
+}
+
+// Feed represents a release feed location: either a local directory or an HTTP(S) URL.
+type Feed struct {
+	Location string
+	Client   *http.Client
+}
+
+// Remote reports whether the feed is an HTTP(S) location.
+func (f *Feed) Remote() bool {
+	return strings.HasPrefix(f.Location, "http://") || strings.HasPrefix(f.Location, "https://")
+}
+
+// Manifest reads and decodes the feed's "manifest.json".
+func (f *Feed) Manifest(ctx context.Context) (*Manifest, error) {
+	content, e := f.Read(ctx, "manifest.json")
+	if e != nil {
+		return nil, e
+	}
+
+	var manifest Manifest
+	if e := json.Unmarshal(content, &manifest); e != nil {
+		return nil, fmt.Errorf("invalid release manifest: %w", e)
+	}
+
+	if manifest.Version == "" || manifest.Checksums == "" || manifest.Signature == "" {
+		return nil, fmt.Errorf("invalid release manifest: \"version\", \"checksums\" and \"signature\" are required")
+	}
+
+	return &manifest, nil
+}
+
+// Read returns the content of a file relative to the feed; absolute HTTP(S) URLs are fetched as-is. Local feeds are
+// read via the context's filesystem (see [system.Get]), and names must be local to the feed (see [filepath.IsLocal]).
+func (f *Feed) Read(ctx context.Context, name string) ([]byte, error) {
+	if strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") || f.Remote() {
+		return f.fetch(ctx, name)
+	}
+
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return nil, fmt.Errorf("invalid file %q: must be relative to, and within, the feed", name)
+	}
+
+	location := strings.TrimPrefix(f.Location, "file://")
+
+	var filesystem = system.Get(ctx).Filesystem
+
+	path := filepath.Join(location, filepath.FromSlash(name))
+
+	information, e := filesystem.Stat(path)
+	if e != nil {
+		return nil, e
+	}
+
+	if information.Size() > Limit {
+		return nil, fmt.Errorf("file exceeds the maximum size of %d bytes", Limit)
+	}
+
+	return filesystem.ReadFile(path)
+}
+
+// fetch performs an HTTP GET of name, resolved relative to the feed's location.
+func (f *Feed) fetch(ctx context.Context, name string) ([]byte, error) {
+	reference, e := url.Parse(name)
+	if e != nil {
+		return nil, e
+	}
+
+	if !reference.IsAbs() {
+		base, e := url.Parse(strings.TrimSuffix(f.Location, "/") + "/")
+		if e != nil {
+			return nil, e
+		}
+
+		reference = base.ResolveReference(reference)
+	}
+
+	request, e := http.NewRequestWithContext(ctx, http.MethodGet, reference.String(), nil)
+	if e != nil {
+		return nil, e
+	}
+
+	request.Header.Set("User-Agent", fmt.Sprintf("%s/%s", constants.Name, build.Version))
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, e := client.Do(request)
+	if e != nil {
+		return nil, e
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from %s: %s", reference.Redacted(), response.Status)
+	}
+
+	return read(response.Body)
+}
+
+// read reads at most [Limit] bytes, failing if the content exceeds it.
+func read(reader io.Reader) ([]byte, error) {
+	content, e := io.ReadAll(io.LimitReader(reader, Limit+1))
+	if e != nil {
+		return nil, e
+	}
+
+	if len(content) > Limit {
+		return nil, fmt.Errorf("file exceeds the maximum size of %d bytes", Limit)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,96 @@
+package update
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// serve returns a feed serving files over HTTP; absent files respond with "404 Not Found".
+func serve(t *testing.T, files map[string]string) *Feed {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[strings.TrimPrefix(r.URL.Path, "/releases/")]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(content))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return &Feed{Location: server.URL + "/releases", Client: server.Client()}
+}
+
+func TestManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		version  string
+		valid    bool
+	}{
+		{name: "valid", manifest: `{"version": "v1.2.3", "assets": ["a.tar.gz"], "checksums": "checksums.txt", "signature": "checksums.txt.sig"}`, version: "v1.2.3", valid: true},
+		{name: "missing-signature", manifest: `{"version": "v1.2.3", "checksums": "checksums.txt"}`},
+		{name: "malformed", manifest: `{"version":`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest, e := serve(t, map[string]string{"manifest.json": test.manifest}).Manifest(context.Background())
+			if !test.valid {
+				if e == nil {
+					t.Fatalf("expected an error for manifest %s", test.manifest)
+				}
+
+				return
+			}
+
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if manifest.Version != test.version {
+				t.Fatalf("expected version %q, received %q", test.version, manifest.Version)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	feed := serve(t, map[string]string{"checksums.txt": "digest  asset.tar.gz\n"})
+
+	content, e := feed.Read(context.Background(), "checksums.txt")
+	if e != nil || string(content) != "digest  asset.tar.gz\n" {
+		t.Fatalf("unexpected content %q: %v", content, e)
+	}
+
+	if _, e := feed.Read(context.Background(), "missing.tar.gz"); e == nil || !strings.Contains(e.Error(), "404") {
+		t.Fatalf("expected a not-found error for a missing asset, received %v", e)
+	}
+}
+
+func TestReadLocal(t *testing.T) {
+	directory := t.TempDir()
+
+	if e := os.WriteFile(filepath.Join(directory, "checksums.txt"), []byte("content"), 0o600); e != nil {
+		t.Fatal(e)
+	}
+
+	feed := &Feed{Location: directory}
+
+	if content, e := feed.Read(context.Background(), "checksums.txt"); e != nil || string(content) != "content" {
+		t.Fatalf("unexpected content %q: %v", content, e)
+	}
+
+	for _, name := range []string{"../checksums.txt", "/etc/passwd", "nested/../../checksums.txt"} {
+		if _, e := feed.Read(context.Background(), name); e == nil {
+			t.Errorf("expected an error for the non-local name %q", name)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/config"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/paths"
+	"template-go-cli/internal/semver"
+	"template-go-cli/internal/system"
+	"template-go-cli/internal/terminal"
+)
+
+// Interval is the minimum duration between update checks.
+const Interval = 24 * time.Hour
+
+// Timeout bounds a single background update check.
+const Timeout = 5 * time.Second
+
+// CI lists environment variables that indicate a continuous-integration environment.
+var CI = []string{"CI", "CONTINUOUS_INTEGRATION", "BUILD_NUMBER", "RUN_ID", "GITHUB_ACTIONS", "GITLAB_CI", "JENKINS_URL", "TF_BUILD", "BUILDKITE", "CIRCLECI", "TRAVIS"}
+
+// Check represents the cached result of the most recent update check.
+type Check struct {
+	Checked time.Time `json:"checked"`
+	Latest  string    `json:"latest,omitempty"`
+}
+
+// Location returns the configured release feed: the "<PREFIX>_UPDATE_FEED" environment variable, otherwise the
+// configuration file's "update.feed"; empty if neither is set.
+func Location(ctx context.Context) string {
+	if v := system.Get(ctx).Getenv(constants.Prefix + "_UPDATE_FEED"); v != "" {
+		return v
+	}
+
+	if configuration, e := config.Current(ctx); e == nil {
+		return configuration.Update.Feed
+	}
+
+	return ""
+}
+
+// Silenced reports whether the update-available notice is suppressed: any of the given streams (typically the
+// command's standard-output and standard-error) isn't a terminal -- the notice mustn't interleave with piped or
+// captured output -- a CI environment variable is set, or the user opted out via "<PREFIX>_NO_UPDATE_NOTIFIER" or
+// the configuration file's "update.silent".
+func Silenced(ctx context.Context, streams ...io.Writer) bool {
+	for _, stream := range streams {
+		if file, valid := stream.(*os.File); !valid || !terminal.Interactive(file) {
+			return true
+		}
+	}
+
+	for _, variable := range append([]string{constants.Prefix + "_NO_UPDATE_NOTIFIER"}, CI...) {
+		if v := system.Get(ctx).Getenv(variable); v != "" && v != "false" && v != "0" {
+			return true
+		}
+	}
+
+	if configuration, e := config.Current(ctx); e == nil && configuration.Update.Silent {
+		return true
+	}
+
+	return false
+}
+
+// Notifier checks the release feed for a newer version in the background, at most once per [Interval]; results are
+// cached in the state directory (see [paths.State]).
+type Notifier struct {
+	done  chan struct{}
+	check Check
+}
+
+// Notify returns a [Notifier], starting a background check of the feed if the cached check is older than
+// [Interval]. The returned value is nil if notices are [Silenced] for the streams or no feed is configured.
+func Notify(ctx context.Context, streams ...io.Writer) *Notifier {
+	feed := Location(ctx)
+	if feed == "" || Silenced(ctx, streams...) {
+		return nil
+	}
+
+	path, e := cache(ctx)
+	if e != nil {
+		return nil
+	}
+
+	var s = system.Get(ctx)
+
+	var notifier = &Notifier{done: make(chan struct{})}
+
+	if content, e := s.Filesystem.ReadFile(path); e == nil {
+		_ = json.Unmarshal(content, &notifier.check)
+	}
+
+	if s.Now().Sub(notifier.check.Checked) < Interval {
+		close(notifier.done)
+
+		return notifier
+	}
+
+	go func() {
+		defer close(notifier.done)
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), Timeout)
+		defer cancel()
+
+		// Record the attempt regardless of its outcome, so an unreachable feed doesn't slow every invocation.
+		var check = Check{Checked: s.Now().UTC(), Latest: notifier.check.Latest}
+		if manifest, e := (&Feed{Location: feed}).Manifest(ctx); e == nil {
+			check.Latest = strings.TrimPrefix(manifest.Version, "v")
+		}
+
+		if content, e := json.Marshal(check); e == nil {
+			if e := s.Filesystem.MkdirAll(filepath.Dir(path), 0o755); e == nil {
+				_ = s.Filesystem.WriteFile(path, content, 0o644)
+			}
+		}
+
+		notifier.check = check
+	}()
+
+	return notifier
+}
+
+// Notice waits at most the grace duration for a pending check, then returns a one-line notice if the latest
+// known release is newer than the running executable; otherwise, an empty string.
+func (n *Notifier) Notice(grace time.Duration) string {
+	if n == nil {
+		return ""
+	}
+
+	select {
+	case <-n.done:
+	case <-time.After(grace):
+		return ""
+	}
+
+	latest, e := semver.Parse(n.check.Latest)
+	if e != nil {
+		return ""
+	}
+
+	current, e := semver.Parse(build.Version)
+	if e != nil || latest.Compare(current) <= 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("A new release of %s is available: %s → %s; run \"%s self-update\" to update.", constants.Name, current, latest, constants.Name)
+}
+
+// cache returns the path of the cached update check.
+func cache(ctx context.Context) (string, error) {
+	directory, e := paths.State(ctx)
+	if e != nil {
+		return "", e
+	}
+
+	return filepath.Join(directory, "update-check.json"), nil
+}
@@ -0,0 +1,157 @@
+package update
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/system"
+)
+
+// environment returns a context whose system has an isolated home directory, the given variables and clock.
+func environment(t *testing.T, variables map[string]string, clock func() time.Time) context.Context {
+	home := t.TempDir()
+
+	environment := map[string]string{"HOME": home, "XDG_CONFIG_HOME": filepath.Join(home, ".config"), "XDG_STATE_HOME": filepath.Join(home, ".local", "state")}
+	for key, value := range variables {
+		environment[key] = value
+	}
+
+	return system.With(context.Background(), system.System{Env: system.Environment(environment), Clock: clock})
+}
+
+func TestSilenced(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables map[string]string
+		config    string
+		streams   []io.Writer
+		null      bool // null appends an opened [os.DevNull] -- a file that isn't a terminal -- to the streams.
+		silenced  bool
+	}{
+		{name: "default"},
+		{name: "opt-out", variables: map[string]string{constants.Prefix + "_NO_UPDATE_NOTIFIER": "1"}, silenced: true},
+		{name: "opt-out-disabled", variables: map[string]string{constants.Prefix + "_NO_UPDATE_NOTIFIER": "false"}},
+		{name: "ci", variables: map[string]string{"GITHUB_ACTIONS": "true"}, silenced: true},
+		{name: "configuration", config: "update:\n    silent: true\n", silenced: true},
+		{name: "captured-stream", streams: []io.Writer{&bytes.Buffer{}}, silenced: true},
+		{name: "non-terminal-file", null: true, silenced: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			variables := map[string]string{}
+			for key, value := range test.variables {
+				variables[key] = value
+			}
+
+			// The process' own CI variables mustn't leak into the test.
+			for _, variable := range CI {
+				if _, ok := variables[variable]; !ok {
+					variables[variable] = ""
+				}
+			}
+
+			if test.config != "" {
+				path := filepath.Join(t.TempDir(), "config.yaml")
+				if e := os.WriteFile(path, []byte(test.config), 0o644); e != nil {
+					t.Fatal(e)
+				}
+
+				variables[constants.Prefix+"_CONFIG"] = path
+			}
+
+			ctx := environment(t, variables, nil)
+
+			var streams = test.streams
+			if test.null {
+				file, e := os.Open(os.DevNull)
+				if e != nil {
+					t.Fatal(e)
+				}
+
+				t.Cleanup(func() { file.Close() })
+
+				streams = append(streams, file)
+			}
+
+			if silenced := Silenced(ctx, streams...); silenced != test.silenced {
+				t.Fatalf("expected silenced to be %t, received %t", test.silenced, silenced)
+			}
+		})
+	}
+}
+
+func TestNotify(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		_, _ = w.Write([]byte(`{"version": "v99.0.0", "assets": ["a.tar.gz"], "checksums": "checksums.txt", "signature": "checksums.txt.sig"}`))
+	}))
+
+	t.Cleanup(server.Close)
+
+	now := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	variables := map[string]string{constants.Prefix + "_UPDATE_FEED": server.URL}
+	for _, variable := range CI {
+		variables[variable] = ""
+	}
+
+	ctx := environment(t, variables, func() time.Time { return now })
+
+	// The first check queries the feed and caches its result.
+	notice := Notify(ctx).Notice(Timeout)
+	if !strings.Contains(notice, "→ 99.0.0") {
+		t.Fatalf("unexpected notice %q", notice)
+	}
+
+	path, e := cache(ctx)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if content, e := os.ReadFile(path); e != nil || !strings.Contains(string(content), `"latest":"99.0.0"`) {
+		t.Fatalf("expected the check to be cached: %s (%v)", content, e)
+	}
+
+	// Within the interval, the cached result is used.
+	now = now.Add(Interval - time.Minute)
+
+	if notice := Notify(ctx).Notice(Timeout); !strings.Contains(notice, "→ 99.0.0") {
+		t.Fatalf("unexpected cached notice %q", notice)
+	}
+
+	if count := requests.Load(); count != 1 {
+		t.Fatalf("expected a single request within the interval, received %d", count)
+	}
+
+	// Once the interval elapses, the feed is queried again.
+	now = now.Add(time.Hour)
+
+	Notify(ctx).Notice(Timeout)
+
+	if count := requests.Load(); count != 2 {
+		t.Fatalf("expected another request once the interval elapsed, received %d", count)
+	}
+
+	// Silenced and unconfigured notifiers are nil, and have no notice.
+	if notifier := Notify(ctx, &bytes.Buffer{}); notifier != nil || notifier.Notice(Timeout) != "" {
+		t.Fatal("expected a nil notifier for a captured stream")
+	}
+
+	if notifier := Notify(environment(t, nil, nil)); notifier != nil {
+		t.Fatal("expected a nil notifier without a feed")
+	}
+}
@@ -0,0 +1,87 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Replace atomically replaces the executable at target with content. The current executable is first renamed to
+// a backup, the new executable renamed into place, then verified by running it with "version --short", which must
+// report the expected version; should any step fail, the backup is restored.
+func Replace(ctx context.Context, target string, content []byte, version string) (e error) {
+	target, e = filepath.EvalSymlinks(target)
+	if e != nil {
+		return e
+	}
+
+	information, e := os.Stat(target)
+	if e != nil {
+		return e
+	}
+
+	directory, name := filepath.Split(target)
+
+	// The temporary file must reside within the target's directory for the rename to be atomic.
+	temporary, e := os.CreateTemp(directory, fmt.Sprintf(".%s-*.new", name))
+	if e != nil {
+		return e
+	}
+
+	defer os.Remove(temporary.Name())
+
+	if _, e := temporary.Write(content); e != nil {
+		temporary.Close()
+		return e
+	}
+
+	if e := temporary.Close(); e != nil {
+		return e
+	}
+
+	if e := os.Chmod(temporary.Name(), information.Mode().Perm()|0o100); e != nil {
+		return e
+	}
+
+	backup := filepath.Join(directory, fmt.Sprintf(".%s.old", name))
+
+	_ = os.Remove(backup)
+
+	if e := os.Rename(target, backup); e != nil {
+		return e
+	}
+
+	defer func() {
+		if e == nil {
+			// Removal fails on platforms that lock running executables; the stale backup is replaced next update.
+			_ = os.Remove(backup)
+
+			return
+		}
+
+		_ = os.Remove(target)
+
+		if exception := os.Rename(backup, target); exception != nil {
+			e = errors.Join(e, fmt.Errorf("rollback failed; the previous executable remains at %q: %w", backup, exception))
+		}
+	}()
+
+	if e := os.Rename(temporary.Name(), target); e != nil {
+		return e
+	}
+
+	output, e := exec.CommandContext(ctx, target, "version", "--short").Output()
+	if e != nil {
+		return fmt.Errorf("updated executable failed verification: %w", e)
+	}
+
+	if actual, expected := strings.TrimPrefix(strings.TrimSpace(string(output)), "v"), strings.TrimPrefix(version, "v"); actual != expected {
+		return fmt.Errorf("updated executable failed verification: expected version %q, received %q", expected, actual)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package update
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// script returns an executable shell script printing version.
+func script(version string) []byte {
+	return []byte("#!/bin/sh\necho " + version + "\n")
+}
+
+func TestReplace(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	tests := []struct {
+		name     string
+		content  []byte
+		version  string
+		replaced bool
+	}{
+		{name: "replaced", content: script("v2.0.0"), version: "2.0.0", replaced: true},
+		{name: "version-mismatch", content: script("1.9.0"), version: "2.0.0"},
+		{name: "failing-executable", content: []byte("#!/bin/sh\nexit 1\n"), version: "2.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			directory := t.TempDir()
+			target := filepath.Join(directory, "executable")
+
+			if e := os.WriteFile(target, script("1.0.0"), 0o755); e != nil {
+				t.Fatal(e)
+			}
+
+			e := Replace(context.Background(), target, test.content, test.version)
+			if test.replaced != (e == nil) {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			content, e := os.ReadFile(target)
+			if e != nil {
+				t.Fatal(e)
+			}
+
+			expected := script("1.0.0")
+			if test.replaced {
+				expected = test.content
+			}
+
+			if string(content) != string(expected) {
+				t.Fatalf("expected the executable to hold %q, received %q", expected, content)
+			}
+
+			// Neither the backup nor the temporary file remain.
+			if entries, _ := os.ReadDir(directory); len(entries) != 1 {
+				t.Fatalf("expected only the executable to remain, received %d entries", len(entries))
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ErrSignature is returned when a checksum file's signature doesn't verify.
+var ErrSignature = errors.New("invalid release signature")
+
+// ErrChecksum is returned when an asset's SHA-256 checksum doesn't match the checksum file's.
+var ErrChecksum = errors.New("checksum mismatch")
+
+// PublicKey decodes a base64-encoded ed25519 public key.
+func PublicKey(encoded string) (ed25519.PublicKey, error) {
+	key, e := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if e != nil {
+		return nil, fmt.Errorf("invalid public key: %w", e)
+	}
+
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key: expected %d bytes, received %d", ed25519.PublicKeySize, len(key))
+	}
+
+	return ed25519.PublicKey(key), nil
+}
+
+// Payload returns the signed content of a release: the release's version (without a "v" prefix) on a
+// "version <version>" line, followed by the checksum file. Binding the version to the assets' digests prevents a
+// feed from presenting a validly signed, older release as a newer one.
+func Payload(version string, checksums []byte) []byte {
+	return append([]byte(fmt.Sprintf("version %s\n", strings.TrimPrefix(version, "v"))), checksums...)
+}
+
+// Sign returns the base64-encoded ed25519 signature of the release's [Payload].
+func Sign(key ed25519.PrivateKey, version string, checksums []byte) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(key, Payload(version, checksums))))
+}
+
+// Verify verifies the base64-encoded ed25519 signature of the release's [Payload].
+func Verify(key ed25519.PublicKey, version string, checksums, signature []byte) error {
+	decoded, e := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if e != nil {
+		return fmt.Errorf("%w: %w", ErrSignature, e)
+	}
+
+	if !ed25519.Verify(key, Payload(version, checksums), decoded) {
+		return ErrSignature
+	}
+
+	return nil
+}
+
+// Checksum verifies content against the checksum file's entry for name.
+func Checksum(checksums []byte, name string, content []byte) error {
+	var expected string
+
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && path.Base(strings.TrimPrefix(fields[1], "*")) == path.Base(name) {
+			expected = strings.ToLower(fields[0])
+			break
+		}
+	}
+
+	if expected == "" {
+		return fmt.Errorf("%w: no checksum for %q", ErrChecksum, path.Base(name))
+	}
+
+	digest := sha256.Sum256(content)
+	if actual := hex.EncodeToString(digest[:]); actual != expected {
+		return fmt.Errorf("%w: %q expected %s, received %s", ErrChecksum, path.Base(name), expected, actual)
+	}
+
+	return nil
+}
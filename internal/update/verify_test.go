@@ -0,0 +1,103 @@
+package update
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	public, private, e := ed25519.GenerateKey(nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	other, _, e := ed25519.GenerateKey(nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	checksums := []byte("0123  asset.tar.gz\n")
+	signature := Sign(private, "v1.2.3", checksums)
+
+	tests := []struct {
+		name      string
+		key       ed25519.PublicKey
+		version   string
+		checksums []byte
+		signature []byte
+		valid     bool
+	}{
+		{name: "valid", key: public, version: "1.2.3", checksums: checksums, signature: signature, valid: true},
+		{name: "other-key", key: other, version: "1.2.3", checksums: checksums, signature: signature},
+		{name: "other-version", key: public, version: "1.2.4", checksums: checksums, signature: signature},
+		{name: "tampered-checksums", key: public, version: "1.2.3", checksums: []byte("4567  asset.tar.gz\n"), signature: signature},
+		{name: "malformed-signature", key: public, version: "1.2.3", checksums: checksums, signature: []byte("not base64!")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := Verify(test.key, test.version, test.checksums, test.signature)
+			if test.valid && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if !test.valid && !errors.Is(e, ErrSignature) {
+				t.Fatalf("expected %v, received %v", ErrSignature, e)
+			}
+		})
+	}
+}
+
+func TestPublicKey(t *testing.T) {
+	public, _, e := ed25519.GenerateKey(nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if key, e := PublicKey(base64.StdEncoding.EncodeToString(public)); e != nil || !key.Equal(public) {
+		t.Fatalf("unexpected key %v: %v", key, e)
+	}
+
+	for _, encoded := range []string{"", "not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, e := PublicKey(encoded); e == nil {
+			t.Errorf("expected an error for %q", encoded)
+		}
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	content := []byte("archive")
+	digest := sha256.Sum256(content)
+
+	checksums := []byte(fmt.Sprintf("%s  %s\n", hex.EncodeToString(digest[:]), "asset.tar.gz"))
+
+	tests := []struct {
+		name    string
+		asset   string
+		content []byte
+		valid   bool
+	}{
+		{name: "valid", asset: "asset.tar.gz", content: content, valid: true},
+		{name: "url", asset: "https://example.com/releases/asset.tar.gz", content: content, valid: true},
+		{name: "mismatch", asset: "asset.tar.gz", content: []byte("tampered")},
+		{name: "missing", asset: "other.tar.gz", content: content},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := Checksum(checksums, test.asset, test.content)
+			if test.valid && e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			if !test.valid && !errors.Is(e, ErrChecksum) {
+				t.Fatalf("expected %v, received %v", ErrChecksum, e)
+			}
+		})
+	}
+}
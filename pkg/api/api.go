@@ -0,0 +1,167 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"maps"
+	"strings"
+	"time"
+
+	"template-go-cli/internal/cli"
+	"template-go-cli/internal/commands"
+	"template-go-cli/internal/exceptions"
+)
+
+// Kind classifies an [Error].
+type Kind string
+
+const (
+	Internal   Kind = "internal"   // Internal represents an unexpected runtime failure.
+	Usage      Kind = "usage"      // Usage represents an unknown command, flag or argument.
+	Validation Kind = "validation" // Validation represents well-formed input that failed semantic validation.
+	NotFound   Kind = "not-found"  // NotFound represents a missing resource (file, plugin, release, etc.).
+	Conflict   Kind = "conflict"   // Conflict represents a resource state conflict (e.g. a dirty working tree).
+	Cancelled  Kind = "cancelled"  // Cancelled represents a cancelled command.
+	Timeout    Kind = "timeout"    // Timeout represents a command that exceeded its deadline.
+)
+
+// Error is the structured error returned by [Run]; use [errors.As] to retrieve it from a returned error.
+type Error struct {
+	Kind    Kind           // Kind classifies the error.
+	Message string         // Message is the human-readable description, including any cause's.
+	Hint    string         // Hint optionally suggests a remediation.
+	Details map[string]any // Details are arbitrary, structured key-value pairs.
+	Code    int            // Code is the exit code the command-line would have terminated with.
+
+	cause error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error's cause (e.g. [context.Canceled]).
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Filesystem is the set of filesystem operations available to commands. Its methods behave as their [os] package
+// counterparts.
+type Filesystem interface {
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+	ReadDir(name string) ([]fs.DirEntry, error)
+	Stat(name string) (fs.FileInfo, error)
+	MkdirAll(path string, perm fs.FileMode) error
+	Chmod(name string, mode fs.FileMode) error
+	Rename(from, to string) error
+	Remove(name string) error
+}
+
+// Options configures a command's execution.
+type Options struct {
+	// Args are the command's arguments -- flags included -- in their command-line form, following the command's path
+	// (e.g. {"--dry-run", "--output", "yaml"}).
+	Args []string
+
+	Stdin  io.Reader // Stdin is the command's standard-input; defaults to an empty reader.
+	Stdout io.Writer // Stdout receives the command's rendered output; defaults to [io.Discard].
+	Stderr io.Writer // Stderr receives the command's logs; defaults to [io.Discard].
+
+	// Env is the command's environment, in [os.Environ]'s "key=value" form; defaults to the process' environment.
+	// Env, Filesystem and Clock are honored as documented by the command-line's embedding options: every built-in
+	// command and setting respects them, though sub-processes (git, plugins) run against the host's filesystem, and
+	// file-valued flags ("@file", "~" paths) are resolved against the host.
+	Env []string
+
+	Filesystem Filesystem       // Filesystem is the command's filesystem; defaults to the host's.
+	Clock      func() time.Time // Clock returns the current time; defaults to [time.Now].
+}
+
+// Run executes the command at path -- its space-separated name(s), relative to the root -- and returns the
+// command's result datum in its "--output json" form. The datum is nil for commands that don't produce one, and may
+// accompany an error (e.g. "lint commit" returns its findings along with a [Validation] error). The returned error,
+// if any, is an [*Error]. Each execution builds its own command tree, so that executions may run concurrently; the
+// execution is bound to ctx.
+func Run(ctx context.Context, path string, options Options) (json.RawMessage, error) {
+	var stdin = options.Stdin
+	if stdin == nil {
+		stdin = strings.NewReader("")
+	}
+
+	var stdout, stderr = options.Stdout, options.Stderr
+	if stdout == nil {
+		stdout = io.Discard
+	}
+
+	if stderr == nil {
+		stderr = io.Discard
+	}
+
+	root, e := cli.New(cli.Options{Stdin: stdin, Stdout: stdout, Stderr: stderr, Env: options.Env, Filesystem: options.Filesystem, Clock: options.Clock})
+	if e != nil {
+		return nil, convert(e)
+	}
+
+	var names = strings.Fields(path)
+
+	command, remaining, e := root.Find(names)
+	if e != nil || len(remaining) > 0 || !commands.Runnable(command) {
+		return nil, convert(exceptions.New(exceptions.Usage, "unknown command %q", path).WithDetail("path", path))
+	}
+
+	datum, e := commands.Invoke(ctx, root, append(names, options.Args...))
+	if e != nil {
+		e = convert(e)
+	}
+
+	if datum == nil {
+		return nil, e
+	}
+
+	content, exception := json.Marshal(datum)
+	if exception != nil {
+		return nil, convert(exceptions.Wrap(exceptions.Internal, exception, "unable to encode the result of %q", path))
+	}
+
+	return content, e
+}
+
+// Call is like [Run], but decodes the command's result datum into a T; see the result types (e.g. [Version]). An
+// [Internal] error is returned if the command succeeded but its datum isn't a T: it can't be decoded as one, or has
+// fields a T lacks.
+func Call[T any](ctx context.Context, path string, options Options) (T, error) {
+	var v T
+
+	content, e := Run(ctx, path, options)
+	if content == nil {
+		return v, e
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	if exception := decoder.Decode(&v); exception != nil && e == nil {
+		return v, convert(exceptions.Wrap(exceptions.Internal, exception, "unable to decode the result of %q as a %T", path, v))
+	}
+
+	return v, e
+}
+
+// convert returns the [*Error] representing e.
+func convert(e error) *Error {
+	exception := exceptions.From(e)
+
+	return &Error{
+		Kind:    Kind(exception.Kind),
+		Message: exception.Error(),
+		Hint:    exception.Hint,
+		Details: maps.Clone(exception.Details),
+		Code:    exception.Code(),
+		cause:   exception.Cause,
+	}
+}
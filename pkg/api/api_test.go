@@ -0,0 +1,174 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"template-go-cli/internal/build"
+	"template-go-cli/internal/constants"
+	"template-go-cli/internal/exceptions"
+	"template-go-cli/pkg/api"
+)
+
+// isolate returns an environment pointing the cli's settings at temporary directories.
+func isolate(t *testing.T) []string {
+	home := t.TempDir()
+
+	return append(os.Environ(),
+		"HOME="+home,
+		"XDG_CONFIG_HOME="+filepath.Join(home, ".config"),
+		"XDG_STATE_HOME="+filepath.Join(home, ".local", "state"),
+		constants.Prefix+"_NO_UPDATE_NOTIFIER=1",
+	)
+}
+
+func TestRun(t *testing.T) {
+	var stdout strings.Builder
+
+	content, e := api.Run(context.Background(), "example", api.Options{Args: []string{"--name", "value", "--output", "yaml"}, Stdout: &stdout, Env: isolate(t)})
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var values map[string]string
+	if e := json.Unmarshal(content, &values); e != nil || values["name"] != "value" {
+		t.Fatalf("unexpected datum: %s", content)
+	}
+
+	if stdout.String() != "name: value\n" {
+		t.Fatalf("unexpected standard-output: %q", stdout.String())
+	}
+}
+
+func TestRunLogging(t *testing.T) {
+	var stderr strings.Builder
+
+	logger := slog.Default()
+
+	if _, e := api.Run(context.Background(), "example", api.Options{Args: []string{"--name", "value", "--log-level", "trace"}, Stderr: &stderr, Env: isolate(t)}); e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	// The host's default logger -- and with it the standard log package's output -- is left untouched.
+	if slog.Default() != logger {
+		t.Fatal("expected the default logger to be unchanged")
+	}
+
+	if !strings.Contains(stderr.String(), "Starting Application") {
+		t.Fatalf("expected the command's logs on its standard-error, received %q", stderr.String())
+	}
+}
+
+func TestRunConcurrently(t *testing.T) {
+	var environment = isolate(t)
+
+	var group sync.WaitGroup
+
+	for index := range 8 {
+		group.Go(func() {
+			name := fmt.Sprintf("value-%d", index)
+
+			values, e := api.Call[map[string]string](context.Background(), "example", api.Options{Args: []string{"--name", name}, Env: environment})
+			if e != nil {
+				t.Errorf("unexpected error: %v", e)
+			} else if values["name"] != name {
+				t.Errorf("expected name %q, received %q", name, values["name"])
+			}
+		})
+	}
+
+	group.Wait()
+}
+
+func TestCall(t *testing.T) {
+	var environment = isolate(t)
+
+	information, e := api.Call[api.Version](context.Background(), "version", api.Options{Env: environment})
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	if information.Version != build.Version {
+		t.Fatalf("expected version %q, received %q", build.Version, information.Version)
+	}
+
+	_, e = api.Call[api.Bump](context.Background(), "version", api.Options{Env: environment})
+
+	var exception *api.Error
+	if !errors.As(e, &exception) || exception.Kind != api.Internal {
+		t.Fatalf("expected an internal error for a mismatched result type, received %v", e)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var environment = isolate(t)
+
+	tests := []struct {
+		name string
+		path string
+		args []string
+		kind api.Kind
+		code int
+	}{
+		{name: "unknown-command", path: "missing", kind: api.Usage, code: 2},
+		{name: "non-runnable", path: "docs", kind: api.Usage, code: 2},
+		{name: "unknown-subcommand", path: "plugin", args: []string{"missing"}, kind: api.Usage, code: 2},
+		{name: "unexpected-argument", path: "version", args: []string{"missing"}, kind: api.Usage, code: 2},
+		{name: "unknown-flag", path: "version", args: []string{"--missing"}, kind: api.Usage, code: 2},
+		{name: "required-flag", path: "example", kind: api.Usage, code: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, e := api.Run(context.Background(), test.path, api.Options{Args: test.args, Env: environment})
+
+			var exception *api.Error
+			if !errors.As(e, &exception) {
+				t.Fatalf("expected an *api.Error, received %v", e)
+			}
+
+			if exception.Kind != test.kind || exception.Code != test.code {
+				t.Fatalf("expected kind %q (%d), received %q (%d): %v", test.kind, test.code, exception.Kind, exception.Code, exception)
+			}
+		})
+	}
+}
+
+func TestKinds(t *testing.T) {
+	tests := map[api.Kind]exceptions.Kind{
+		api.Internal:   exceptions.Internal,
+		api.Usage:      exceptions.Usage,
+		api.Validation: exceptions.Validation,
+		api.NotFound:   exceptions.NotFound,
+		api.Conflict:   exceptions.Conflict,
+		api.Cancelled:  exceptions.Cancelled,
+		api.Timeout:    exceptions.Timeout,
+	}
+
+	for kind, expected := range tests {
+		if string(kind) != string(expected) {
+			t.Errorf("expected kind %q, received %q", expected, kind)
+		}
+	}
+}
+
+func TestFindings(t *testing.T) {
+	findings, e := api.Call[[]api.Finding](context.Background(), "lint commit", api.Options{Args: []string{"-"}, Stdin: strings.NewReader("feature: something\n"), Env: isolate(t)})
+
+	var exception *api.Error
+	if !errors.As(e, &exception) || exception.Kind != api.Validation {
+		t.Fatalf("expected a validation error, received %v", e)
+	}
+
+	if len(findings) != 1 || findings[0].Rule != "type-enum" {
+		t.Fatalf("unexpected findings: %+v", findings)
+	}
+}
@@ -0,0 +1,5 @@
+// Package api exposes the cli's commands to other Go programs. A command is run in-process, by its path (e.g.
+// "release bump") and command-line arguments, and returns its result datum -- the value otherwise rendered to
+// standard-output -- decoded into one of the package's result types, along with an [*Error] describing any failure.
+// Commands execute via the same code as the command-line; see [Run] and [Call].
+package api
@@ -0,0 +1,154 @@
+package api
+
+// The commands' result types, by command path; see [Call]. Each mirrors the command's "--output json" form, and is
+// verified field-by-field against the datum the command records.
+type (
+	// Changelog is the result of "changelog".
+	Changelog struct {
+		From     string    `json:"from,omitempty"` // From is the (exclusive) starting revision; empty for the full history.
+		To       string    `json:"to"`
+		Previous string    `json:"previous"`
+		Next     string    `json:"next"`
+		Bump     string    `json:"bump"` // Bump is the suggested increment ("major", "minor" or "patch"), or empty.
+		Breaking []Entry   `json:"breaking,omitempty"`
+		Sections []Section `json:"sections"`
+	}
+
+	// Section is a [Changelog]'s group of entries sharing a commit type.
+	Section struct {
+		Type    string  `json:"type"`
+		Title   string  `json:"title"`
+		Entries []Entry `json:"entries"`
+	}
+
+	// Entry is a [Changelog]'s commit.
+	Entry struct {
+		Hash        string   `json:"hash"`
+		Scope       string   `json:"scope,omitempty"`
+		Description string   `json:"description"`
+		Breaking    bool     `json:"breaking"`
+		Notes       []string `json:"notes,omitempty"`
+	}
+
+	// Completion is the result of "completion install" and "completion uninstall".
+	Completion struct {
+		Shell   string `json:"shell"`
+		Script  string `json:"script"`            // Script is the completion script's path.
+		RC      string `json:"rc,omitempty"`      // RC is the shell's rc file; empty when the script is auto-loaded.
+		Snippet string `json:"snippet,omitempty"` // Snippet is the rc file content that loads the script.
+	}
+
+	// Diagnostic is an element of the result of "doctor".
+	Diagnostic struct {
+		Name    string `json:"name"`
+		Status  string `json:"status"` // Status is one of "pass", "warn" or "fail".
+		Message string `json:"message"`
+	}
+
+	// Manifest is the result of "docs manifest": a command and, recursively, its sub-commands.
+	Manifest struct {
+		Name      string     `json:"name"`
+		Path      string     `json:"path"`  // Path is the full command path (e.g. "template-go-cli plugin list").
+		Usage     string     `json:"usage"` // Usage is the command's use-line.
+		Short     string     `json:"short"`
+		Long      string     `json:"long,omitempty"`
+		Example   string     `json:"example,omitempty"`
+		Aliases   []string   `json:"aliases,omitempty"`
+		Group     string     `json:"group,omitempty"` // Group is the title of the command's help-group on its parent.
+		Flags     []Flag     `json:"flags,omitempty"`
+		Inherited []Flag     `json:"inherited,omitempty"` // Inherited are the persistent flags inherited from parent commands.
+		Commands  []Manifest `json:"commands,omitempty"`
+	}
+
+	// Flag is a [Manifest]'s flag.
+	Flag struct {
+		Name      string `json:"name"`
+		Shorthand string `json:"shorthand,omitempty"`
+		Type      string `json:"type"`
+		Default   string `json:"default,omitempty"`
+		Usage     string `json:"usage"`
+	}
+
+	// File is an element of the result of "generate command".
+	File struct {
+		Path string `json:"path"`
+	}
+
+	// Finding is an element of the result of "lint commit".
+	Finding struct {
+		Source   string `json:"source"` // Source is the message file or commit hash.
+		Line     int    `json:"line"`
+		Column   int    `json:"column"`
+		Severity string `json:"severity"` // Severity is either "error" or "warning".
+		Rule     string `json:"rule"`
+		Message  string `json:"message"`
+	}
+
+	// Hook is the result of "lint install-hooks".
+	Hook struct {
+		Name string `json:"name"`
+		Path string `json:"path"`
+	}
+
+	// Plugin is an element of the result of "plugin list".
+	Plugin struct {
+		Name     string   `json:"name"`               // Name is the sub-command name.
+		Path     string   `json:"path"`               // Path is the absolute path to the resolved executable.
+		Shadowed []string `json:"shadowed,omitempty"` // Shadowed lists executables of the same name overshadowed by Path.
+		Conflict bool     `json:"conflict"`           // Conflict is true when a built-in command of the same name exists.
+	}
+
+	// Change is an element of the result of "init".
+	Change struct {
+		Path string `json:"path"`
+	}
+
+	// Bump is the result of "release bump".
+	Bump struct {
+		Previous string `json:"previous"`
+		Next     string `json:"next"`
+		Tag      string `json:"tag"`
+		Message  string `json:"message"`
+		Clean    bool   `json:"clean"`
+		DryRun   bool   `json:"dry-run"`
+	}
+
+	// Update is the result of "self-update".
+	Update struct {
+		Current string `json:"current"`
+		Latest  string `json:"latest"`
+		Asset   string `json:"asset,omitempty"`
+		Path    string `json:"path,omitempty"`
+		Updated bool   `json:"updated"`
+	}
+
+	// Version is the result of "version".
+	Version struct {
+		Version      string            `json:"version"`
+		Commit       string            `json:"commit"`
+		Date         string            `json:"date"`
+		Sources      string            `json:"sources"`
+		Go           string            `json:"go"`
+		Platform     string            `json:"platform"`
+		Module       string            `json:"module,omitempty"`
+		VCS          *VCS              `json:"vcs,omitempty"`
+		Settings     map[string]string `json:"settings,omitempty"`
+		Dependencies []Dependency      `json:"dependencies,omitempty"`
+	}
+
+	// VCS is a [Version]'s version control information.
+	VCS struct {
+		System   string `json:"system,omitempty"`
+		Revision string `json:"revision,omitempty"`
+		Time     string `json:"time,omitempty"`
+		Modified bool   `json:"modified"`
+	}
+
+	// Dependency is a [Version]'s module dependency.
+	Dependency struct {
+		Path    string `json:"path"`
+		Version string `json:"version"`
+		Sum     string `json:"sum,omitempty"`
+		Replace string `json:"replace,omitempty"`
+	}
+)
@@ -0,0 +1,125 @@
+package api_test
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"template-go-cli/internal/changelog"
+	"template-go-cli/internal/commands/lint"
+	"template-go-cli/internal/commands/release"
+	"template-go-cli/internal/commands/selfupdate"
+	"template-go-cli/internal/commands/version"
+	"template-go-cli/internal/completion"
+	"template-go-cli/internal/diagnostics"
+	"template-go-cli/internal/plugins"
+	"template-go-cli/internal/rebrand"
+	"template-go-cli/internal/reference"
+	"template-go-cli/internal/scaffold"
+	"template-go-cli/pkg/api"
+)
+
+// fields collects the json-encoded fields of t -- recursively, keyed by their dotted path (e.g. "sections[].type") --
+// and their kinds. Embedded structs are flattened, as encoding/json does, and a type recurring within itself (e.g. a
+// manifest's sub-commands) is recorded once.
+func fields(t reflect.Type, path string, into map[string]string, seen []reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		fields(t.Elem(), path+"[]", into, seen)
+
+	case reflect.Map:
+		into[path] = "map"
+
+		fields(t.Elem(), path+"{}", into, seen)
+
+	case reflect.Struct:
+		if slices.Contains(seen, t) {
+			into[path] = "recursive"
+
+			return
+		}
+
+		for i := range t.NumField() {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+
+			if field.Anonymous && name == "" {
+				fields(field.Type, path, into, append(seen, t))
+
+				continue
+			}
+
+			if name == "" {
+				name = field.Name
+			}
+
+			if path != "" {
+				name = path + "." + name
+			}
+
+			fields(field.Type, name, into, append(seen, t))
+		}
+
+	default:
+		into[path] = t.Kind().String()
+	}
+}
+
+// TestResults verifies each public result type (see [api.Call]) has exactly the json fields of the internal datum
+// its command records, so that neither drifts from the other.
+func TestResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		internal any
+		public   any
+	}{
+		{name: "changelog", internal: changelog.Changelog{}, public: api.Changelog{}},
+		{name: "completion", internal: completion.Target{}, public: api.Completion{}},
+		{name: "doctor", internal: diagnostics.Result{}, public: api.Diagnostic{}},
+		{name: "docs manifest", internal: reference.Command{}, public: api.Manifest{}},
+		{name: "generate command", internal: scaffold.File{}, public: api.File{}},
+		{name: "lint commit", internal: lint.Finding{}, public: api.Finding{}},
+		{name: "lint install-hooks", internal: lint.Hook{}, public: api.Hook{}},
+		{name: "plugin list", internal: plugins.Plugin{}, public: api.Plugin{}},
+		{name: "init", internal: rebrand.Change{}, public: api.Change{}},
+		{name: "release bump", internal: release.Result{}, public: api.Bump{}},
+		{name: "self-update", internal: selfupdate.Result{}, public: api.Update{}},
+		{name: "version", internal: version.Information{}, public: api.Version{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var internal, public = map[string]string{}, map[string]string{}
+
+			fields(reflect.TypeOf(test.internal), "", internal, nil)
+			fields(reflect.TypeOf(test.public), "", public, nil)
+
+			for _, path := range slices.Sorted(maps.Keys(internal)) {
+				if kind, exists := public[path]; !exists {
+					t.Errorf("%T lacks the field %q (%s) of %T", test.public, path, internal[path], test.internal)
+				} else if kind != internal[path] {
+					t.Errorf("%T's field %q is a %s, rather than the %s of %T", test.public, path, kind, internal[path], test.internal)
+				}
+			}
+
+			for _, path := range slices.Sorted(maps.Keys(public)) {
+				if _, exists := internal[path]; !exists {
+					t.Errorf("%T has the field %q, which %T lacks", test.public, path, test.internal)
+				}
+			}
+		})
+	}
+}